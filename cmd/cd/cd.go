@@ -0,0 +1,33 @@
+package cd
+
+import (
+	cdImpl "github.com/renegumroad/gum-cli/internal/commands/cd"
+	"github.com/renegumroad/gum-cli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	var impl *cdImpl.CdImpl
+
+	cmd := &cobra.Command{
+		Use:   "cd <repo>",
+		Short: "goes to a repository cloned by gum clone.",
+		Long: `Changes the current directory to a repository cloned by gum clone, named repo, org/repo or
+host/org/repo. This relies on the gum shell function of ~/.gum/.shell_config (re-run gum init if
+your config predates it). Without it, the path of the repository is printed.
+    `,
+		Example: `  gum cd web
+  gum cd gumroad/web
+`,
+		Args: cobra.ExactArgs(1),
+		PreRun: func(cmd *cobra.Command, args []string) {
+			impl = cdImpl.New(cmd.OutOrStdout(), args[0])
+			utils.CheckFatalError(impl.Validate())
+		},
+		Run: func(_ *cobra.Command, _ []string) {
+			utils.CheckFatalError(impl.Run())
+		},
+	}
+
+	return cmd
+}
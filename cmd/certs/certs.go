@@ -0,0 +1,40 @@
+package certs
+
+import (
+	certsImpl "github.com/renegumroad/gum-cli/internal/commands/certs"
+	"github.com/renegumroad/gum-cli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	var impl *certsImpl.CertsImpl
+	trust := false
+
+	cmd := &cobra.Command{
+		Use:   "certs",
+		Short: "sets up the local certificate authority and development certificates.",
+		Long: `Creates a local certificate authority in ~/.gum/ca and issues the certificates declared in the
+certs entries of the gum.yml file in the current directory. Certificates are issued again when
+their hosts change or they are about to expire.
+
+Trusting the CA requires sudo. Without --trust, the commands doing it are printed.
+    `,
+		Example: `  # Issue the certificates of the project
+  gum certs
+
+  # Also add the local CA to the system trust store
+  gum certs --trust
+`,
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			impl = certsImpl.New(cmd.OutOrStdout(), trust)
+			utils.CheckFatalError(impl.Validate())
+		},
+		Run: func(_ *cobra.Command, _ []string) {
+			utils.CheckFatalError(impl.Run())
+		},
+	}
+
+	cmd.Flags().BoolVar(&trust, "trust", false, "add the local CA to the system trust store")
+
+	return cmd
+}
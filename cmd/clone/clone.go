@@ -0,0 +1,36 @@
+package clone
+
+import (
+	cloneImpl "github.com/renegumroad/gum-cli/internal/commands/clone"
+	"github.com/renegumroad/gum-cli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	var impl *cloneImpl.CloneImpl
+
+	cmd := &cobra.Command{
+		Use:   "clone <org>/<repo>",
+		Short: "clones a repository in the source root.",
+		Long: `Clones a repository in <src_root>/<host>/<org>/<repo>. The source root is ~/src unless src_root is
+set in ~/.gum/config.yml, the host is github.com unless given, and repositories are cloned over
+ssh unless clone_protocol is set to https.
+    `,
+		Example: `  # Clone into ~/src/github.com/gumroad/web
+  gum clone gumroad/web
+
+  # Then go there
+  gum cd web
+`,
+		Args: cobra.ExactArgs(1),
+		PreRun: func(_ *cobra.Command, args []string) {
+			impl = cloneImpl.New(args[0])
+			utils.CheckFatalError(impl.Validate())
+		},
+		Run: func(_ *cobra.Command, _ []string) {
+			utils.CheckFatalError(impl.Run())
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,27 @@
+package dev
+
+import (
+	"github.com/renegumroad/gum-cli/internal/commands/dev"
+	"github.com/renegumroad/gum-cli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func newActionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "actions",
+		Short: "lists the named actions usable in gum.yml.",
+		Long: `Lists the named actions that can be used in the up entries of gum.yml, along with
+the parameters they accept under with:.
+    `,
+		Example: `  # List the named actions and their parameters
+  gum dev actions
+`,
+		Run: func(cmd *cobra.Command, _ []string) {
+			impl := dev.NewActions(cmd.OutOrStdout())
+			utils.CheckFatalError(impl.Validate())
+			utils.CheckFatalError(impl.Run())
+		},
+	}
+
+	return cmd
+}
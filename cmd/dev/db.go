@@ -0,0 +1,45 @@
+package dev
+
+import (
+	"github.com/renegumroad/gum-cli/internal/commands/dev"
+	"github.com/renegumroad/gum-cli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func newDbCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "db",
+		Short: "manages the databases declared in gum.yml.",
+	}
+
+	cmd.AddCommand(newDbResetCmd())
+
+	return cmd
+}
+
+func newDbResetCmd() *cobra.Command {
+	var impl *dev.DbResetImpl
+
+	cmd := &cobra.Command{
+		Use:   "reset [name...]",
+		Short: "drops and bootstraps the databases again.",
+		Long: `Drops the databases declared in the gum.yml file in the current directory, then creates,
+migrates and seeds them again. Without names, every database is reset.
+    `,
+		Example: `  # Reset every database of the project
+  gum dev db reset
+
+  # Reset a single database
+  gum dev db reset app_development
+`,
+		PreRun: func(_ *cobra.Command, args []string) {
+			impl = dev.NewDbReset(args)
+			utils.CheckFatalError(impl.Validate())
+		},
+		Run: func(_ *cobra.Command, _ []string) {
+			utils.CheckFatalError(impl.Run())
+		},
+	}
+
+	return cmd
+}
@@ -12,6 +12,10 @@ func Cmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(newUpCmd())
+	cmd.AddCommand(newDownCmd())
+	cmd.AddCommand(newActionsCmd())
+	cmd.AddCommand(newDbCmd())
+	cmd.AddCommand(newLinksCmd())
 
 	return cmd
 }
@@ -0,0 +1,31 @@
+package dev
+
+import (
+	"github.com/renegumroad/gum-cli/internal/commands/dev"
+	"github.com/renegumroad/gum-cli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func newDownCmd() *cobra.Command {
+	impl := dev.NewDown()
+
+	cmd := &cobra.Command{
+		Use:   "down",
+		Short: "stops the services started by gum dev up.",
+		Long: `Stops the background services declared in the gum.yml file in the current directory.
+
+Packages installed by gum dev up are left untouched.
+    `,
+		Example: `  # Stop the services of the project
+  gum dev down
+`,
+		PreRun: func(_ *cobra.Command, _ []string) {
+			utils.CheckFatalError(impl.Validate())
+		},
+		Run: func(_ *cobra.Command, _ []string) {
+			utils.CheckFatalError(impl.Run())
+		},
+	}
+
+	return cmd
+}
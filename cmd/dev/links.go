@@ -0,0 +1,40 @@
+package dev
+
+import (
+	"github.com/renegumroad/gum-cli/internal/commands/dev"
+	"github.com/renegumroad/gum-cli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func newLinksCmd() *cobra.Command {
+	var impl *dev.LinksImpl
+	adopt := false
+
+	cmd := &cobra.Command{
+		Use:   "links",
+		Short: "links the dotfiles declared in gum.yml into the home directory.",
+		Long: `Creates the links entries of the gum.yml file in the current directory, as gum dev up does:
+existing files are backed up with a .gum-backup suffix before being replaced.
+
+With --adopt, existing files are moved into the project or the dotfiles repository instead,
+replacing their version there, so that local changes can be committed.
+    `,
+		Example: `  # Link the dotfiles
+  gum dev links
+
+  # Keep the local versions of the dotfiles
+  gum dev links --adopt
+`,
+		PreRun: func(_ *cobra.Command, _ []string) {
+			impl = dev.NewLinks(adopt)
+			utils.CheckFatalError(impl.Validate())
+		},
+		Run: func(_ *cobra.Command, _ []string) {
+			utils.CheckFatalError(impl.Run())
+		},
+	}
+
+	cmd.Flags().BoolVar(&adopt, "adopt", false, "move existing files into the project or dotfiles repository")
+
+	return cmd
+}
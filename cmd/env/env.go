@@ -0,0 +1,31 @@
+package env
+
+import (
+	envImpl "github.com/renegumroad/gum-cli/internal/commands/env"
+	"github.com/renegumroad/gum-cli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	var impl *envImpl.EnvImpl
+
+	cmd := &cobra.Command{
+		Use:   "env",
+		Short: "prints the secrets of gum.yml as shell exports.",
+		Long: `Resolves the secrets declared in the gum.yml file in the current directory with their provider
+(op://, file:// or env://) and prints them as export statements, to be evaluated by the shell.
+    `,
+		Example: `  # Export the project secrets in the current shell
+  eval "$(gum env)"
+`,
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			impl = envImpl.New(cmd.OutOrStdout())
+			utils.CheckFatalError(impl.Validate())
+		},
+		Run: func(_ *cobra.Command, _ []string) {
+			utils.CheckFatalError(impl.Run())
+		},
+	}
+
+	return cmd
+}
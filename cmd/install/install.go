@@ -0,0 +1,52 @@
+package install
+
+import (
+	installImpl "github.com/renegumroad/gum-cli/internal/commands/install"
+	"github.com/renegumroad/gum-cli/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	var impl *installImpl.InstallImpl
+	name := ""
+	asset := ""
+	path := ""
+
+	cmd := &cobra.Command{
+		Use:   "install <owner>/<repo>[@version]",
+		Short: "installs a tool published as GitHub releases.",
+		Long: `Installs the binary of a GitHub release in /opt/gumroad/bin, the latest release unless a version
+is given. The asset is picked by the OS and arch in its name, and verified against the checksums
+published with the release. Installed versions are recorded in /opt/gumroad/manifest.json.
+
+GITHUB_TOKEN authenticates the requests, for private repositories, and github_api_url in
+~/.gum/config.yml points gum to another GitHub API.
+    `,
+		Example: `  # Install the latest release
+  gum install gumroad/tool
+
+  # Install a version, as another binary name
+  gum install gumroad/tool@1.2.0 --name gumroad-tool
+
+  # Pick the asset when its name is unusual
+  gum install gumroad/tool --asset 'tool-{{ .OS }}-{{ .Arch }}-static.tar.gz'
+
+  # Install a binary named differently from the repository
+  gum install cli/cli --name gh --path 'gh_{{ .Version }}_{{ .OS }}_{{ .Arch }}/bin/gh'
+`,
+		Args: cobra.ExactArgs(1),
+		PreRun: func(_ *cobra.Command, args []string) {
+			impl = installImpl.New(args[0], name, asset, path)
+			utils.CheckFatalError(impl.Validate())
+		},
+		Run: func(_ *cobra.Command, _ []string) {
+			utils.CheckFatalError(impl.Run())
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "installed binary name, the repository name by default")
+	cmd.Flags().StringVar(&asset, "asset", "", "glob of the asset name, templated with .Version, .OS and .Arch")
+	cmd.Flags().StringVar(&path, "path", "", "binary inside the archive, templated like --asset, the repository name by default")
+
+	return cmd
+}
@@ -6,8 +6,13 @@ package cmd
 import (
 	"os"
 
+	"github.com/renegumroad/gum-cli/cmd/cd"
+	"github.com/renegumroad/gum-cli/cmd/certs"
+	"github.com/renegumroad/gum-cli/cmd/clone"
 	"github.com/renegumroad/gum-cli/cmd/dev"
+	"github.com/renegumroad/gum-cli/cmd/env"
 	initCmd "github.com/renegumroad/gum-cli/cmd/init"
+	"github.com/renegumroad/gum-cli/cmd/install"
 	"github.com/renegumroad/gum-cli/internal/log"
 	"github.com/renegumroad/gum-cli/internal/version"
 
@@ -33,6 +38,11 @@ func rootCmd() *cobra.Command {
 
 	rootCmd.AddCommand(initCmd.Cmd())
 	rootCmd.AddCommand(dev.Cmd())
+	rootCmd.AddCommand(certs.Cmd())
+	rootCmd.AddCommand(env.Cmd())
+	rootCmd.AddCommand(clone.Cmd())
+	rootCmd.AddCommand(cd.Cmd())
+	rootCmd.AddCommand(install.Cmd())
 
 	return rootCmd
 }
@@ -8,12 +8,82 @@ import (
 	"github.com/renegumroad/gum-cli/internal/systeminfo"
 )
 
+// namedAction registers an action that gum.yml can refer to by name, along
+// with the with: parameters it accepts.
+type namedAction struct {
+	schema Schema
+	create func(with map[string]interface{}) (Action, error)
+}
+
 var (
-	namedActions = map[string]Action{
-		"golang":      NewGolangAction(),
-		"ruby":        NewRubyAction(),
-		"xcode":       NewXcodeAction(),
-		"brew_ensure": NewBrewEnsureAction(),
+	namedActions = map[string]namedAction{
+		"golang": {
+			schema: golangSchema,
+			create: func(with map[string]interface{}) (Action, error) {
+				args := GolangArgs{}
+				if err := decodeParams("golang", with, &args); err != nil {
+					return nil, err
+				}
+
+				return NewGolangAction(args), nil
+			},
+		},
+		"ruby": {
+			create: func(_ map[string]interface{}) (Action, error) { return NewRubyAction(), nil },
+		},
+		"node": {
+			create: func(_ map[string]interface{}) (Action, error) { return NewNodeAction(), nil },
+		},
+		"python": {
+			create: func(_ map[string]interface{}) (Action, error) { return NewPythonAction(), nil },
+		},
+		"java": {
+			schema: javaSchema,
+			create: func(with map[string]interface{}) (Action, error) {
+				args := JavaArgs{}
+				if err := decodeParams("java", with, &args); err != nil {
+					return nil, err
+				}
+
+				return NewJavaAction(args), nil
+			},
+		},
+		"rust": {
+			schema: rustSchema,
+			create: func(with map[string]interface{}) (Action, error) {
+				args := RustArgs{}
+				if err := decodeParams("rust", with, &args); err != nil {
+					return nil, err
+				}
+
+				return NewRustAction(args), nil
+			},
+		},
+		"docker_compose": {
+			schema: dockerComposeSchema,
+			create: func(with map[string]interface{}) (Action, error) {
+				args := DockerComposeArgs{}
+				if err := decodeParams("docker_compose", with, &args); err != nil {
+					return nil, err
+				}
+
+				return NewDockerComposeAction(args), nil
+			},
+		},
+		"xcode": {
+			schema: xcodeSchema,
+			create: func(with map[string]interface{}) (Action, error) {
+				args := XcodeArgs{}
+				if err := decodeParams("xcode", with, &args); err != nil {
+					return nil, err
+				}
+
+				return NewXcodeAction(args), nil
+			},
+		},
+		"brew_ensure": {
+			create: func(_ map[string]interface{}) (Action, error) { return NewBrewEnsureAction(), nil },
+		},
 	}
 )
 
@@ -31,11 +101,69 @@ type Action interface {
 }
 
 func SupportedByConfig(name string) bool {
-	return namedActions[name] != nil && namedActions[name].IsPublic()
+	action := Get(name)
+
+	return action != nil && action.IsPublic()
 }
 
+// Get returns the named action with its default parameters, or nil when
+// there is no such action.
 func Get(name string) Action {
-	return namedActions[name]
+	action, err := NewNamedAction(name, nil)
+	if err != nil {
+		return nil
+	}
+
+	return action
+}
+
+// NewNamedAction returns the named action configured with the with:
+// parameters of gum.yml.
+func NewNamedAction(name string, with map[string]interface{}) (Action, error) {
+	named, found := namedActions[name]
+	if !found {
+		return nil, errors.Errorf("Named action %s does not exist", name)
+	}
+
+	if err := named.schema.Validate(name, with); err != nil {
+		return nil, err
+	}
+
+	return named.create(with)
+}
+
+// ValidateParams checks the with: parameters of a named action against its
+// schema without creating the action.
+func ValidateParams(name string, with map[string]interface{}) error {
+	named, found := namedActions[name]
+	if !found {
+		return errors.Errorf("Named action %s does not exist", name)
+	}
+
+	return named.schema.Validate(name, with)
+}
+
+// PublicNames returns the sorted names of the actions gum.yml can refer to.
+func PublicNames() []string {
+	names := []string{}
+	for name := range namedActions {
+		if SupportedByConfig(name) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// Describe renders the with: parameters accepted by a named action.
+func Describe(name string) (string, error) {
+	named, found := namedActions[name]
+	if !found {
+		return "", errors.Errorf("Named action %s does not exist", name)
+	}
+
+	return named.schema.Describe(), nil
 }
 
 func SupportedByCurrentPlatform(action Action) bool {
@@ -44,13 +172,28 @@ func SupportedByCurrentPlatform(action Action) bool {
 	return slices.Contains(action.Platforms(), sys.CurrentPlatform())
 }
 
+// mergeable is implemented by actions that install a set of packages, so that
+// every instance of them in a run can be folded into a single batched action.
+type mergeable interface {
+	Action
+	merged(other Action) Action
+}
+
+// lateAction is implemented by actions that need the rest of the
+// environment to be set up, so they are moved after every other action. Only
+// databases do, since they need the services and runtimes of gum.yml.
+type lateAction interface {
+	Action
+	runsAfterSetup()
+}
+
 type ActionHandler struct {
 	Actions []Action
 }
 
 func NewActionHandler(actions []Action) *ActionHandler {
 	return &ActionHandler{
-		Actions: buildActionList(actions...),
+		Actions: orderActions(mergeActions(buildActionList(actions...))),
 	}
 }
 
@@ -122,6 +265,52 @@ func buildActionList(actions ...Action) []Action {
 	return sortedActions
 }
 
+// mergeActions replaces the mergeable actions of the list by one action per
+// name, holding all of their requirements and placed where the first one was,
+// so that the packages declared first are installed before the actions that
+// follow them in gum.yml. Mergeable actions only depend on actions that set up
+// their package manager, so moving the later ones earlier is safe.
+func mergeActions(actions []Action) []Action {
+	result := []Action{}
+	positions := map[string]int{}
+
+	for _, action := range actions {
+		if _, ok := action.(mergeable); !ok {
+			result = append(result, action)
+			continue
+		}
+
+		if i, found := positions[action.Name()]; found {
+			log.Debugf("Merging %s into %s", action.Identifier(), result[i].Identifier())
+			result[i] = result[i].(mergeable).merged(action)
+			continue
+		}
+
+		positions[action.Name()] = len(result)
+		result = append(result, action)
+	}
+
+	return result
+}
+
+// orderActions moves the late actions after the others, keeping the
+// relative order of both groups.
+func orderActions(actions []Action) []Action {
+	result := []Action{}
+	late := []Action{}
+
+	for _, action := range actions {
+		if _, ok := action.(lateAction); ok {
+			late = append(late, action)
+			continue
+		}
+
+		result = append(result, action)
+	}
+
+	return append(result, late...)
+}
+
 func containsAction(a Action) func(b Action) bool {
 	return func(b Action) bool {
 		return a.Identifier() == b.Identifier()
@@ -1,24 +1,38 @@
 package actions
 
 import (
+	"fmt"
+
 	"github.com/pkg/errors"
 	"github.com/renegumroad/gum-cli/internal/cli/homebrew"
 	"github.com/renegumroad/gum-cli/internal/systeminfo"
 )
 
+// brewRequirement is a package together with the place it was declared,
+// so that merged brew actions can explain where a conflict comes from.
+type brewRequirement struct {
+	pkg    homebrew.Package
+	source string
+}
+
 type BrewAction struct {
-	brew     homebrew.Client
-	packages []homebrew.Package
+	brew         homebrew.Client
+	requirements []brewRequirement
 }
 
-func NewBrewAction(packages []homebrew.Package) Action {
-	return newBrewActionWithClient(packages, homebrew.New())
+func NewBrewAction(source string, packages []homebrew.Package) Action {
+	return newBrewActionWithClient(source, packages, homebrew.Default())
 }
 
-func newBrewActionWithClient(packages []homebrew.Package, brew homebrew.Client) *BrewAction {
+func newBrewActionWithClient(source string, packages []homebrew.Package, brew homebrew.Client) *BrewAction {
+	requirements := make([]brewRequirement, 0, len(packages))
+	for _, pkg := range packages {
+		requirements = append(requirements, brewRequirement{pkg: pkg, source: source})
+	}
+
 	return &BrewAction{
-		brew:     brew,
-		packages: packages,
+		brew:         brew,
+		requirements: requirements,
 	}
 }
 
@@ -28,8 +42,8 @@ func (act *BrewAction) Name() string {
 
 func (act *BrewAction) Identifier() string {
 	id := "brew"
-	for _, pkg := range act.packages {
-		id += "-" + pkg.Name
+	for _, req := range act.requirements {
+		id += "-" + req.pkg.Name
 	}
 
 	return id
@@ -47,8 +61,8 @@ func (act *BrewAction) Deps() []Action {
 
 func (act *BrewAction) Validate() error {
 	missingNameCount := 0
-	for _, pkg := range act.packages {
-		if pkg.Name == "" {
+	for _, req := range act.requirements {
+		if req.pkg.Name == "" {
 			missingNameCount++
 		}
 	}
@@ -56,8 +70,14 @@ func (act *BrewAction) Validate() error {
 	var err error
 	if missingNameCount > 0 {
 		err = errors.Errorf("Failed %s action validation: %d package(s) missing name.", act.Name(), missingNameCount)
-	} else if len(act.packages) == 0 {
+	} else if len(act.requirements) == 0 {
 		err = errors.Errorf("Failed %s action validation: no packages specified.", act.Name())
+	} else if conflicts := act.conflicts(); len(conflicts) > 0 {
+		msg := fmt.Sprintf("Failed %s action validation: conflicting package declarations:", act.Name())
+		for _, conflict := range conflicts {
+			msg = msg + "\n  " + conflict
+		}
+		err = errors.Errorf(msg)
 	}
 
 	return err
@@ -72,7 +92,7 @@ func (act *BrewAction) ShouldRun() bool {
 		return true
 	}
 
-	for _, pkg := range act.packages {
+	for _, pkg := range act.packages() {
 		if !act.brew.IsInstalled(pkg) {
 			return true
 		}
@@ -82,12 +102,70 @@ func (act *BrewAction) ShouldRun() bool {
 }
 
 func (act *BrewAction) Run() error {
-	for _, pkg := range act.packages {
-		err := act.brew.EnsureInstalled(pkg)
-		if err != nil {
-			return err
+	return act.brew.EnsureInstalledAll(act.packages())
+}
+
+func (act *BrewAction) merged(other Action) Action {
+	requirements := append([]brewRequirement{}, act.requirements...)
+
+	return &BrewAction{
+		brew:         act.brew,
+		requirements: append(requirements, other.(*BrewAction).requirements...),
+	}
+}
+
+// packages returns the normalized, de-duplicated list of packages to install,
+// in the order in which they were first declared.
+func (act *BrewAction) packages() []homebrew.Package {
+	seen := map[string]bool{}
+	packages := []homebrew.Package{}
+
+	for _, req := range act.requirements {
+		pkg := homebrew.Normalize(req.pkg)
+		if seen[pkg.Name] {
+			continue
 		}
+
+		seen[pkg.Name] = true
+		packages = append(packages, pkg)
+	}
+
+	return packages
+}
+
+func (act *BrewAction) conflicts() []string {
+	first := map[string]brewRequirement{}
+	conflicts := []string{}
+
+	for _, req := range act.requirements {
+		pkg := homebrew.Normalize(req.pkg)
+
+		prev, found := first[pkg.Name]
+		if !found {
+			first[pkg.Name] = brewRequirement{pkg: pkg, source: req.source}
+			continue
+		}
+
+		if prev.pkg.Cask != pkg.Cask {
+			conflicts = append(conflicts, fmt.Sprintf(
+				"%s is declared as a %s by %s and as a %s by %s",
+				pkg.Name, brewKind(prev.pkg), prev.source, brewKind(pkg), req.source,
+			))
+		} else if prev.pkg.Link != pkg.Link {
+			conflicts = append(conflicts, fmt.Sprintf(
+				"%s is declared with link: %t by %s and with link: %t by %s",
+				pkg.Name, prev.pkg.Link, prev.source, pkg.Link, req.source,
+			))
+		}
+	}
+
+	return conflicts
+}
+
+func brewKind(pkg homebrew.Package) string {
+	if pkg.Cask {
+		return "cask"
 	}
 
-	return nil
+	return "formula"
 }
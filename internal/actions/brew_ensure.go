@@ -27,7 +27,7 @@ func (a *BrewEnsureAction) Platforms() []systeminfo.Platform {
 
 func (a *BrewEnsureAction) Deps() []Action {
 	return []Action{
-		NewXcodeAction(),
+		NewXcodeAction(XcodeArgs{}),
 		NewScriptAction(&ScriptActionArgs{
 			Title:   "Install Homebrew",
 			Command: "$(curl -fsSL https://raw.githubusercontent.com/Homebrew/install/master/install.sh)",
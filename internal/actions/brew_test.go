@@ -24,14 +24,14 @@ func (s *brewActionSuite) SetupTest() {
 }
 
 func (s *brewActionSuite) TestValidateNoPackagesError() {
-	act := NewBrewAction([]homebrew.Package{})
+	act := NewBrewAction("gum.yml up[0]", []homebrew.Package{})
 
 	err := act.Validate()
 	s.Require().ErrorContains(err, "no packages specified")
 }
 
 func (s *brewActionSuite) TestValidateSomePackagesDoNotHaveName() {
-	act := NewBrewAction([]homebrew.Package{{Name: ""}, {Name: "package1"}})
+	act := NewBrewAction("gum.yml up[0]", []homebrew.Package{{Name: ""}, {Name: "package1"}})
 
 	err := act.Validate()
 	s.Require().ErrorContains(err, "package(s) missing name")
@@ -39,14 +39,69 @@ func (s *brewActionSuite) TestValidateSomePackagesDoNotHaveName() {
 
 func (s *brewActionSuite) TestRun() {
 	pkgs := []homebrew.Package{{Name: "package1"}, {Name: "package2"}}
-	for _, pkg := range pkgs {
-		s.mockBrew.EXPECT().EnsureInstalled(pkg).Return(nil)
-	}
-	act := newBrewActionWithClient(pkgs, s.mockBrew)
+	s.mockBrew.EXPECT().EnsureInstalledAll(pkgs).Return(nil)
+	act := newBrewActionWithClient("gum.yml up[0]", pkgs, s.mockBrew)
 
 	err := act.Run()
 	s.Require().NoError(err)
-	s.mockBrew.AssertNumberOfCalls(s.T(), "EnsureInstalled", 2)
+	s.mockBrew.AssertNumberOfCalls(s.T(), "EnsureInstalledAll", 1)
+}
+
+func (s *brewActionSuite) TestMergeDeduplicatesPackages() {
+	script := NewScriptAction(&ScriptActionArgs{Title: "test", Command: "true"})
+	actions := mergeActions([]Action{
+		newBrewActionWithClient("gum.yml up[0]", []homebrew.Package{{Name: "jq"}, {Name: "go"}}, s.mockBrew),
+		script,
+		newBrewActionWithClient("gum.yml up[2]", []homebrew.Package{{Name: "JQ"}, {Name: "homebrew/core/yq"}}, s.mockBrew),
+		newBrewActionWithClient("action golang", []homebrew.Package{{Name: "go"}}, s.mockBrew),
+	})
+
+	s.Require().Len(actions, 2)
+	s.Require().Equal(script, actions[1])
+
+	merged, ok := actions[0].(*BrewAction)
+	s.Require().True(ok)
+	s.Require().NoError(merged.Validate())
+	s.Require().Equal([]homebrew.Package{{Name: "jq"}, {Name: "go"}, {Name: "yq"}}, merged.packages())
+
+	s.mockBrew.EXPECT().EnsureInstalledAll(merged.packages()).Return(nil)
+	s.Require().NoError(merged.Run())
+}
+
+func (s *brewActionSuite) TestMergeKeepsFirstPositionAcrossDependents() {
+	golang := newGolangActionWithComponents(GolangArgs{Tools: []string{}}, nil, s.mockBrew, nil)
+	actions := mergeActions([]Action{
+		newBrewActionWithClient("gum.yml up[0]", []homebrew.Package{{Name: "pre-commit"}}, s.mockBrew),
+		golang.Deps()[0],
+		golang,
+		newBrewActionWithClient("gum.yml up[2]", []homebrew.Package{{Name: "go", Cask: true}}, s.mockBrew),
+	})
+
+	s.Require().Len(actions, 2)
+	s.Require().Equal(golang, actions[1])
+	s.Require().Equal([]homebrew.Package{{Name: "pre-commit"}, {Name: "go"}}, actions[0].(*BrewAction).packages())
+	s.Require().ErrorContains(actions[0].Validate(), "go is declared as a formula by action golang and as a cask by gum.yml up[2]")
+}
+
+func (s *brewActionSuite) TestMergeReportsCaskConflict() {
+	actions := mergeActions([]Action{
+		newBrewActionWithClient("gum.yml up[0]", []homebrew.Package{{Name: "docker"}}, s.mockBrew),
+		newBrewActionWithClient("gum.yml up[1]", []homebrew.Package{{Name: "docker", Cask: true}}, s.mockBrew),
+	})
+
+	s.Require().Len(actions, 1)
+	err := actions[0].Validate()
+	s.Require().ErrorContains(err, "docker is declared as a formula by gum.yml up[0] and as a cask by gum.yml up[1]")
+}
+
+func (s *brewActionSuite) TestMergeReportsLinkConflict() {
+	actions := mergeActions([]Action{
+		newBrewActionWithClient("action ruby", []homebrew.Package{{Name: "openssl"}}, s.mockBrew),
+		newBrewActionWithClient("gum.yml up[3]", []homebrew.Package{{Name: "openssl", Link: true}}, s.mockBrew),
+	})
+
+	err := actions[0].Validate()
+	s.Require().ErrorContains(err, "openssl is declared with link: false by action ruby and with link: true by gum.yml up[3]")
 }
 
 func TestBrewActionSuite(t *testing.T) {
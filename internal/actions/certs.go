@@ -0,0 +1,140 @@
+package actions
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/certs"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+)
+
+// CertsArgs lists the hostnames of a development certificate and where to
+// write it, relative to the project directory. The paths default to
+// certs/<host>.pem and certs/<host>-key.pem, named after the first host.
+type CertsArgs struct {
+	Hosts []string `yaml:"hosts"`
+	Cert  string   `yaml:"cert,omitempty"`
+	Key   string   `yaml:"key,omitempty"`
+}
+
+// Paths returns the certificate and key paths of the args, resolved in dir.
+func (args *CertsArgs) Paths(dir string) (string, string) {
+	name := "certificate"
+	if len(args.Hosts) > 0 {
+		name = strings.ReplaceAll(args.Hosts[0], "*", "_wildcard")
+	}
+
+	cert, key := args.Cert, args.Key
+	if cert == "" {
+		cert = filepath.Join("certs", name+".pem")
+	}
+	if key == "" {
+		key = filepath.Join("certs", name+"-key.pem")
+	}
+
+	if !filepath.IsAbs(cert) {
+		cert = filepath.Join(dir, cert)
+	}
+	if !filepath.IsAbs(key) {
+		key = filepath.Join(dir, key)
+	}
+
+	return cert, key
+}
+
+type CertsAction struct {
+	source string
+	args   *CertsArgs
+	fs     filesystem.Client
+	certs  certs.Client
+}
+
+func NewCertsAction(source string, args *CertsArgs) *CertsAction {
+	return newCertsActionWithComponents(source, args, filesystem.New(), certs.New())
+}
+
+func newCertsActionWithComponents(source string, args *CertsArgs, fs filesystem.Client, certsClient certs.Client) *CertsAction {
+	return &CertsAction{
+		source: source,
+		args:   args,
+		fs:     fs,
+		certs:  certsClient,
+	}
+}
+
+func (a *CertsAction) Name() string {
+	return "certs"
+}
+
+func (a *CertsAction) Identifier() string {
+	return "certs-" + strings.Join(a.args.Hosts, "-")
+}
+
+func (a *CertsAction) IsPublic() bool {
+	return true
+}
+
+func (a *CertsAction) Platforms() []systeminfo.Platform {
+	return []systeminfo.Platform{systeminfo.Darwin, systeminfo.Linux}
+}
+
+func (a *CertsAction) Deps() []Action {
+	return []Action{}
+}
+
+func (a *CertsAction) Validate() error {
+	if len(a.args.Hosts) == 0 {
+		return errors.Errorf("%s: certs require at least one host", a.source)
+	}
+
+	return nil
+}
+
+func (a *CertsAction) ShouldRun() bool {
+	if err := a.certs.CheckCA(); err != nil {
+		log.Debugf("%s", err)
+		return true
+	}
+
+	if err := a.check(); err != nil {
+		log.Debugf("%s", err)
+		return true
+	}
+
+	return !a.certs.IsTrusted()
+}
+
+// Run creates the CA and issues the certificate when needed. Trusting the CA
+// requires sudo, so it is left to gum certs --trust.
+func (a *CertsAction) Run() error {
+	dir, err := a.fs.CurrentDir()
+	if err != nil {
+		return err
+	}
+
+	cert, key := a.args.Paths(dir)
+	commands, err := a.certs.EnsureCertificate(a.args.Hosts, cert, key)
+	if err != nil {
+		return err
+	}
+
+	if len(commands) > 0 {
+		log.Warnf("The local CA is not trusted by the system. Run gum certs --trust, or:\n%s", strings.Join(commands, "\n"))
+	}
+
+	return nil
+}
+
+func (a *CertsAction) check() error {
+	dir, err := a.fs.CurrentDir()
+	if err != nil {
+		return err
+	}
+
+	cert, _ := a.args.Paths(dir)
+
+	return a.certs.CheckCertificate(cert, a.args.Hosts)
+}
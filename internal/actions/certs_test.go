@@ -0,0 +1,69 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/certs/mockcerts"
+	"github.com/renegumroad/gum-cli/internal/filesystem/mockfilesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type certsActionSuite struct {
+	suite.Suite
+	mockFs    *mockfilesystem.MockClient
+	mockCerts *mockcerts.MockClient
+}
+
+func (s *certsActionSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *certsActionSuite) SetupTest() {
+	s.mockFs = mockfilesystem.NewMockClient(s.T())
+	s.mockCerts = mockcerts.NewMockClient(s.T())
+	s.mockFs.EXPECT().CurrentDir().Return("/app", nil).Maybe()
+}
+
+func (s *certsActionSuite) newAction(args *CertsArgs) *CertsAction {
+	return newCertsActionWithComponents("gum.yml up[0]", args, s.mockFs, s.mockCerts)
+}
+
+func (s *certsActionSuite) TestPaths() {
+	cert, key := (&CertsArgs{Hosts: []string{"*.gumroad.dev"}}).Paths("/app")
+	s.Require().Equal("/app/certs/_wildcard.gumroad.dev.pem", cert)
+	s.Require().Equal("/app/certs/_wildcard.gumroad.dev-key.pem", key)
+
+	cert, key = (&CertsArgs{Hosts: []string{"app.gumroad.dev"}, Cert: "config/ssl/dev.crt", Key: "/etc/ssl/dev.key"}).Paths("/app")
+	s.Require().Equal("/app/config/ssl/dev.crt", cert)
+	s.Require().Equal("/etc/ssl/dev.key", key)
+}
+
+func (s *certsActionSuite) TestShouldRunUpToDate() {
+	s.mockCerts.EXPECT().CheckCA().Return(nil)
+	s.mockCerts.EXPECT().CheckCertificate("/app/certs/app.gumroad.dev.pem", []string{"app.gumroad.dev"}).Return(nil)
+	s.mockCerts.EXPECT().IsTrusted().Return(true)
+
+	s.Require().False(s.newAction(&CertsArgs{Hosts: []string{"app.gumroad.dev"}}).ShouldRun())
+}
+
+func (s *certsActionSuite) TestShouldRunExpiringCertificate() {
+	s.mockCerts.EXPECT().CheckCA().Return(nil)
+	s.mockCerts.EXPECT().CheckCertificate("/app/certs/app.gumroad.dev.pem", []string{"app.gumroad.dev"}).Return(errors.Errorf("expires on 2024-06-20"))
+
+	s.Require().True(s.newAction(&CertsArgs{Hosts: []string{"app.gumroad.dev"}}).ShouldRun())
+}
+
+func (s *certsActionSuite) TestRunLeavesTrustToCertsCommand() {
+	s.mockCerts.EXPECT().EnsureCertificate([]string{"app.gumroad.dev"}, "/app/certs/app.gumroad.dev.pem", "/app/certs/app.gumroad.dev-key.pem").
+		Return([]string{"sudo update-ca-certificates"}, nil)
+
+	s.Require().NoError(s.newAction(&CertsArgs{Hosts: []string{"app.gumroad.dev"}}).Run())
+	s.mockCerts.AssertNotCalled(s.T(), "Trust")
+}
+
+func TestCertsActionSuite(t *testing.T) {
+	suite.Run(t, new(certsActionSuite))
+}
@@ -0,0 +1,287 @@
+package actions
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/state"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+)
+
+const defaultDatabaseTimeout = 30 * time.Second
+
+var databasePorts = map[string]int{
+	"postgresql": 5432,
+	"mysql":      3306,
+	"sqlite":     0,
+}
+
+// DatabaseStep is a command bootstrapping a database. As for scripts, the
+// command is skipped when the test command succeeds.
+type DatabaseStep struct {
+	Test    string `yaml:"test,omitempty"`
+	Command string `yaml:"command"`
+}
+
+// DatabaseArgs declares a database of the project and the commands creating,
+// migrating and seeding it. Drop is only used by gum dev db reset.
+type DatabaseArgs struct {
+	Name    string        `yaml:"name"`
+	Engine  string        `yaml:"engine"`
+	Host    string        `yaml:"host,omitempty"`
+	Port    int           `yaml:"port,omitempty"`
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+	Create  *DatabaseStep `yaml:"create,omitempty"`
+	Migrate *DatabaseStep `yaml:"migrate,omitempty"`
+	Seed    *DatabaseStep `yaml:"seed,omitempty"`
+	Drop    *DatabaseStep `yaml:"drop,omitempty"`
+}
+
+type DatabaseAction struct {
+	source   string
+	args     *DatabaseArgs
+	fs       filesystem.Client
+	state    state.Client
+	cmdGen   cmdexec.CmdGenerator
+	dial     func(addr string, timeout time.Duration) error
+	interval time.Duration
+}
+
+func NewDatabaseAction(source string, args *DatabaseArgs) *DatabaseAction {
+	return newDatabaseActionWithComponents(source, args, filesystem.New(), state.New(), cmdexec.NewCommandGenerator(), dialTCP)
+}
+
+func newDatabaseActionWithComponents(
+	source string,
+	args *DatabaseArgs,
+	fs filesystem.Client,
+	stateClient state.Client,
+	gen cmdexec.CmdGenerator,
+	dial func(addr string, timeout time.Duration) error,
+) *DatabaseAction {
+	return &DatabaseAction{
+		source:   source,
+		args:     args,
+		fs:       fs,
+		state:    stateClient,
+		cmdGen:   gen,
+		dial:     dial,
+		interval: servicePollInterval,
+	}
+}
+
+func (a *DatabaseAction) Name() string {
+	return "database"
+}
+
+func (a *DatabaseAction) Identifier() string {
+	return "database-" + a.args.Name
+}
+
+func (a *DatabaseAction) IsPublic() bool {
+	return true
+}
+
+func (a *DatabaseAction) Platforms() []systeminfo.Platform {
+	return []systeminfo.Platform{systeminfo.Darwin, systeminfo.Linux}
+}
+
+func (a *DatabaseAction) Deps() []Action {
+	return []Action{}
+}
+
+func (a *DatabaseAction) runsAfterSetup() {}
+
+func (a *DatabaseAction) Validate() error {
+	if a.args.Name == "" {
+		return errors.Errorf("%s: database name is required", a.source)
+	}
+
+	if _, found := databasePorts[a.args.Engine]; !found {
+		return errors.Errorf("%s: database %s has an unsupported engine %q. Supported: postgresql, mysql, sqlite", a.source, a.args.Name, a.args.Engine)
+	}
+
+	if a.args.Create == nil && a.args.Migrate == nil && a.args.Seed == nil {
+		return errors.Errorf("%s: database %s requires a create, migrate or seed command", a.source, a.args.Name)
+	}
+
+	for _, name := range []string{"create", "migrate", "seed", "drop"} {
+		if step := a.step(name); step != nil && step.Command == "" {
+			return errors.Errorf("%s: the %s command of database %s is empty", a.source, name, a.args.Name)
+		}
+	}
+
+	return nil
+}
+
+func (a *DatabaseAction) ShouldRun() bool {
+	for _, step := range []*DatabaseStep{a.args.Create, a.args.Migrate} {
+		if step != nil && a.script(step).ShouldRun() {
+			return true
+		}
+	}
+
+	return a.args.Seed != nil && !a.isSeeded()
+}
+
+// Run waits for the database server, then runs the create and migrate
+// commands whose tests fail and seeds the database unless gum already did.
+func (a *DatabaseAction) Run() error {
+	if err := a.waitForServer(); err != nil {
+		return err
+	}
+
+	for _, step := range []*DatabaseStep{a.args.Create, a.args.Migrate} {
+		if step == nil {
+			continue
+		}
+
+		script := a.script(step)
+		if !script.ShouldRun() {
+			continue
+		}
+
+		if err := script.Run(); err != nil {
+			return errors.Errorf("Failed to set up database %s: %s", a.args.Name, err)
+		}
+	}
+
+	if a.args.Seed == nil || a.isSeeded() {
+		return nil
+	}
+
+	return a.seed()
+}
+
+// Reset drops the database and bootstraps it again, seeding it even when it
+// was seeded before.
+func (a *DatabaseAction) Reset() error {
+	if a.args.Drop == nil {
+		return errors.Errorf("%s: database %s has no drop command to reset it", a.source, a.args.Name)
+	}
+
+	if err := a.waitForServer(); err != nil {
+		return err
+	}
+
+	log.Infof("Resetting database %s", a.args.Name)
+
+	for _, step := range []*DatabaseStep{a.args.Drop, a.args.Create, a.args.Migrate} {
+		if step == nil {
+			continue
+		}
+
+		if err := a.script(step).Run(); err != nil {
+			return errors.Errorf("Failed to reset database %s: %s", a.args.Name, err)
+		}
+	}
+
+	if err := a.state.Delete(a.seededKey()); err != nil {
+		return err
+	}
+
+	if a.args.Seed == nil {
+		return nil
+	}
+
+	return a.seed()
+}
+
+func (a *DatabaseAction) seed() error {
+	log.Infof("Seeding database %s", a.args.Name)
+
+	if err := a.script(a.args.Seed).Run(); err != nil {
+		return errors.Errorf("Failed to seed database %s: %s", a.args.Name, err)
+	}
+
+	return a.state.Set(a.seededKey(), time.Now().UTC().Format(time.RFC3339))
+}
+
+func (a *DatabaseAction) isSeeded() bool {
+	_, found, err := a.state.Get(a.seededKey())
+	if err != nil {
+		log.Debugf("Unable to read the seed state of database %s: %s", a.args.Name, err)
+		return false
+	}
+
+	return found
+}
+
+// seededKey identifies the database in the state store. The same database
+// name may be used by several projects.
+func (a *DatabaseAction) seededKey() string {
+	dir, err := a.fs.CurrentDir()
+	if err != nil {
+		dir = ""
+	}
+
+	return fmt.Sprintf("database:%s:%s:seeded", dir, a.args.Name)
+}
+
+func (a *DatabaseAction) waitForServer() error {
+	port := a.args.Port
+	if port == 0 {
+		port = databasePorts[a.args.Engine]
+	}
+
+	// sqlite databases are files, there is no server to wait for
+	if port == 0 {
+		return nil
+	}
+
+	host := a.args.Host
+	if host == "" {
+		host = defaultServiceHost
+	}
+
+	timeout := a.args.Timeout
+	if timeout == 0 {
+		timeout = defaultDatabaseTimeout
+	}
+
+	addr := net.JoinHostPort(host, strconv.Itoa(port))
+	log.Infof("Waiting for the %s server of database %s on %s", a.args.Engine, a.args.Name, addr)
+
+	deadline := time.Now().Add(timeout)
+	for {
+		err := a.dial(addr, time.Second)
+		if err == nil {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return errors.Errorf("The %s server of database %s did not accept connections on %s after %s: %s", a.args.Engine, a.args.Name, addr, timeout, err)
+		}
+
+		time.Sleep(a.interval)
+	}
+}
+
+func (a *DatabaseAction) script(step *DatabaseStep) *ScriptAction {
+	return newScriptActionWithComponents(&ScriptActionArgs{
+		Title:   fmt.Sprintf("database %s", a.args.Name),
+		Test:    step.Test,
+		Command: step.Command,
+	}, a.cmdGen)
+}
+
+func (a *DatabaseAction) step(name string) *DatabaseStep {
+	switch name {
+	case "create":
+		return a.args.Create
+	case "migrate":
+		return a.args.Migrate
+	case "seed":
+		return a.args.Seed
+	case "drop":
+		return a.args.Drop
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package actions
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec/fakecmdexec"
+	"github.com/renegumroad/gum-cli/internal/filesystem/mockfilesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/state/mockstate"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const databaseTestKey = "database:/app:app_development:seeded"
+
+type databaseActionSuite struct {
+	suite.Suite
+	mockFs    *mockfilesystem.MockClient
+	mockState *mockstate.MockClient
+}
+
+func (s *databaseActionSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *databaseActionSuite) SetupTest() {
+	s.mockFs = mockfilesystem.NewMockClient(s.T())
+	s.mockState = mockstate.NewMockClient(s.T())
+	s.mockFs.EXPECT().CurrentDir().Return("/app", nil).Maybe()
+}
+
+func (s *databaseActionSuite) args() *DatabaseArgs {
+	return &DatabaseArgs{
+		Name:    "app_development",
+		Engine:  "postgresql",
+		Create:  &DatabaseStep{Test: "psql -lqt | grep -qw app_development", Command: "bin/rails db:create"},
+		Migrate: &DatabaseStep{Command: "bin/rails db:migrate"},
+		Seed:    &DatabaseStep{Command: "bin/rails db:seed"},
+		Drop:    &DatabaseStep{Command: "bin/rails db:drop"},
+	}
+}
+
+func (s *databaseActionSuite) newAction(args *DatabaseArgs, cmds ...fakecmdexec.SettableCommand) *DatabaseAction {
+	dial := func(addr string, _ time.Duration) error {
+		s.Require().Equal("127.0.0.1:5432", addr)
+		return nil
+	}
+
+	act := newDatabaseActionWithComponents("gum.yml up[0]", args, s.mockFs, s.mockState, fakecmdexec.NewCmdGenerator(cmds...), dial)
+	act.interval = time.Millisecond
+
+	return act
+}
+
+func (s *databaseActionSuite) TestValidate() {
+	args := s.args()
+	args.Engine = "oracle"
+	s.Require().ErrorContains(s.newAction(args).Validate(), `unsupported engine "oracle"`)
+
+	args = s.args()
+	args.Migrate.Command = ""
+	s.Require().ErrorContains(s.newAction(args).Validate(), "gum.yml up[0]: the migrate command of database app_development is empty")
+
+	s.Require().NoError(s.newAction(s.args()).Validate())
+}
+
+func (s *databaseActionSuite) TestRunCreatesMigratesAndSeeds() {
+	createTest := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{Err: errors.New("exit status 1")})
+	create := fakecmdexec.NewNoOpCommand()
+	migrate := fakecmdexec.NewNoOpCommand()
+	seed := fakecmdexec.NewNoOpCommand()
+	s.mockState.EXPECT().Get(databaseTestKey).Return("", false, nil)
+	s.mockState.EXPECT().Set(databaseTestKey, mock.Anything).Return(nil)
+
+	err := s.newAction(s.args(), createTest, create, migrate, seed).Run()
+
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"-c", "psql -lqt | grep -qw app_development"}, createTest.Args())
+	s.Require().Equal([]string{"-c", "bin/rails db:create"}, create.Args())
+	s.Require().Equal([]string{"-c", "bin/rails db:migrate"}, migrate.Args())
+	s.Require().Equal([]string{"-c", "bin/rails db:seed"}, seed.Args())
+}
+
+func (s *databaseActionSuite) TestRunSkipsSeedingTwice() {
+	createTest := fakecmdexec.NewNoOpCommand()
+	migrate := fakecmdexec.NewNoOpCommand()
+	s.mockState.EXPECT().Get(databaseTestKey).Return("2024-06-01T10:00:00Z", true, nil)
+
+	err := s.newAction(s.args(), createTest, migrate).Run()
+
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"-c", "bin/rails db:migrate"}, migrate.Args())
+	s.mockState.AssertNotCalled(s.T(), "Set", mock.Anything, mock.Anything)
+}
+
+func (s *databaseActionSuite) TestShouldRunWhenUpToDate() {
+	args := s.args()
+	args.Migrate.Test = "bin/rails db:migrate:status | grep -qv down"
+	s.mockState.EXPECT().Get(databaseTestKey).Return("2024-06-01T10:00:00Z", true, nil)
+
+	s.Require().False(s.newAction(args, fakecmdexec.NewNoOpCommand(), fakecmdexec.NewNoOpCommand()).ShouldRun())
+}
+
+func (s *databaseActionSuite) TestRunTimesOutWaitingForServer() {
+	args := s.args()
+	args.Timeout = 5 * time.Millisecond
+	act := s.newAction(args)
+	act.dial = func(_ string, _ time.Duration) error {
+		return errors.New("connection refused")
+	}
+
+	err := act.Run()
+
+	s.Require().ErrorContains(err, "The postgresql server of database app_development did not accept connections on 127.0.0.1:5432")
+}
+
+func (s *databaseActionSuite) TestResetSeedsAgain() {
+	drop := fakecmdexec.NewNoOpCommand()
+	create := fakecmdexec.NewNoOpCommand()
+	migrate := fakecmdexec.NewNoOpCommand()
+	seed := fakecmdexec.NewNoOpCommand()
+	s.mockState.EXPECT().Delete(databaseTestKey).Return(nil)
+	s.mockState.EXPECT().Set(databaseTestKey, mock.Anything).Return(nil)
+
+	err := s.newAction(s.args(), drop, create, migrate, seed).Reset()
+
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"-c", "bin/rails db:drop"}, drop.Args())
+	s.Require().Equal([]string{"-c", "bin/rails db:create"}, create.Args())
+	s.Require().Equal([]string{"-c", "bin/rails db:seed"}, seed.Args())
+}
+
+func (s *databaseActionSuite) TestResetWithoutDropCommand() {
+	args := s.args()
+	args.Drop = nil
+
+	s.Require().ErrorContains(s.newAction(args).Reset(), "database app_development has no drop command")
+}
+
+func (s *databaseActionSuite) TestDatabasesRunAfterOtherActions() {
+	database := s.newAction(s.args())
+	service := NewServiceAction("gum.yml up[1]", []ServiceArgs{{Name: "postgresql@16"}})
+	script := NewScriptAction(&ScriptActionArgs{Title: "setup", Command: "true"})
+
+	s.Require().Equal([]Action{service, script, database}, orderActions([]Action{database, service, script}))
+}
+
+func TestDatabaseActionSuite(t *testing.T) {
+	suite.Run(t, new(databaseActionSuite))
+}
@@ -0,0 +1,199 @@
+package actions
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/compose"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+)
+
+const (
+	defaultComposeTimeout = 2 * time.Minute
+	composePollInterval   = 2 * time.Second
+)
+
+var dockerComposeSchema = Schema{
+	{
+		Name:        "file",
+		Type:        StringParam,
+		Description: "Compose file. Defaults to the one docker compose finds in the project directory.",
+	},
+	{
+		Name:        "services",
+		Type:        StringListParam,
+		Description: "Services to start. Defaults to every service of the compose file.",
+	},
+	{
+		Name:        "timeout",
+		Type:        DurationParam,
+		Description: "How long to wait for the services to be running and healthy. Defaults to 2m.",
+	},
+}
+
+// DockerComposeArgs configures the docker_compose action.
+type DockerComposeArgs struct {
+	File     string        `yaml:"file"`
+	Services []string      `yaml:"services"`
+	Timeout  time.Duration `yaml:"timeout"`
+}
+
+type DockerComposeAction struct {
+	args     DockerComposeArgs
+	compose  compose.Client
+	interval time.Duration
+}
+
+func NewDockerComposeAction(args DockerComposeArgs) *DockerComposeAction {
+	return newDockerComposeActionWithComponents(args, compose.New())
+}
+
+func newDockerComposeActionWithComponents(args DockerComposeArgs, composeClient compose.Client) *DockerComposeAction {
+	if args.Timeout == 0 {
+		args.Timeout = defaultComposeTimeout
+	}
+
+	return &DockerComposeAction{
+		args:     args,
+		compose:  composeClient,
+		interval: composePollInterval,
+	}
+}
+
+func (a *DockerComposeAction) Name() string {
+	return "docker_compose"
+}
+
+func (a *DockerComposeAction) Identifier() string {
+	id := "docker_compose"
+	if a.args.File != "" {
+		id += "-" + a.args.File
+	}
+	for _, service := range a.args.Services {
+		id += "-" + service
+	}
+
+	return id
+}
+
+func (a *DockerComposeAction) IsPublic() bool {
+	return true
+}
+
+func (a *DockerComposeAction) Platforms() []systeminfo.Platform {
+	return []systeminfo.Platform{systeminfo.Darwin, systeminfo.Linux}
+}
+
+func (a *DockerComposeAction) Deps() []Action {
+	return []Action{}
+}
+
+func (a *DockerComposeAction) Validate() error {
+	return nil
+}
+
+func (a *DockerComposeAction) ShouldRun() bool {
+	if err := a.compose.IsAvailable(); err != nil {
+		log.Debugf("%s", err)
+		return true
+	}
+
+	notReady, err := a.notReady(false)
+	if err != nil {
+		log.Debugf("%s", err)
+		return true
+	}
+
+	return len(notReady) > 0
+}
+
+func (a *DockerComposeAction) Run() error {
+	if err := a.compose.IsAvailable(); err != nil {
+		return err
+	}
+
+	notReady, err := a.notReady(false)
+	if err != nil {
+		return err
+	}
+
+	if len(notReady) == 0 {
+		log.Infof("Compose services are already running")
+		return nil
+	}
+
+	if err := a.compose.Up(a.args.File, a.args.Services); err != nil {
+		return err
+	}
+
+	return a.waitForServices()
+}
+
+// waitForServices polls docker compose ps until the services are running
+// and their healthchecks pass.
+func (a *DockerComposeAction) waitForServices() error {
+	log.Infof("Waiting for compose services to be healthy")
+
+	deadline := time.Now().Add(a.args.Timeout)
+	for {
+		notReady, err := a.notReady(true)
+		if err != nil {
+			return err
+		}
+
+		if len(notReady) == 0 {
+			log.Infof("Compose services are ready")
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return errors.Errorf("Compose services %s were not healthy after %s. Check docker compose logs", strings.Join(notReady, ", "), a.args.Timeout)
+		}
+
+		log.Debugf("Compose services %s are not ready yet", strings.Join(notReady, ", "))
+		time.Sleep(a.interval)
+	}
+}
+
+// notReady lists the selected services without a running and healthy
+// container. Services that exited successfully are one-shot services that
+// completed once started, or services stopped cleanly before a restart: they
+// count as ready after up, or while other services are running.
+func (a *DockerComposeAction) notReady(started bool) ([]string, error) {
+	services := a.args.Services
+	if len(services) == 0 {
+		all, err := a.compose.Services(a.args.File)
+		if err != nil {
+			return nil, err
+		}
+		services = all
+	}
+
+	containers, err := a.compose.Ps(a.args.File)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := slices.DeleteFunc(slices.Clone(containers), func(container compose.Container) bool {
+		return !slices.Contains(services, container.Service)
+	})
+	completedIsReady := started || slices.ContainsFunc(selected, func(container compose.Container) bool {
+		return container.State == "running"
+	})
+
+	notReady := []string{}
+	for _, service := range services {
+		ready := slices.ContainsFunc(selected, func(container compose.Container) bool {
+			return container.Service == service && (container.IsReady() || (completedIsReady && container.IsCompleted()))
+		})
+
+		if !ready {
+			notReady = append(notReady, service)
+		}
+	}
+
+	return notReady, nil
+}
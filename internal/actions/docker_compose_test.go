@@ -0,0 +1,124 @@
+package actions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/compose"
+	"github.com/renegumroad/gum-cli/internal/cli/compose/mockcompose"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type dockerComposeActionSuite struct {
+	suite.Suite
+	mockCompose *mockcompose.MockClient
+}
+
+func (s *dockerComposeActionSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *dockerComposeActionSuite) SetupTest() {
+	s.mockCompose = mockcompose.NewMockClient(s.T())
+}
+
+func (s *dockerComposeActionSuite) newAction(args DockerComposeArgs) *DockerComposeAction {
+	act := newDockerComposeActionWithComponents(args, s.mockCompose)
+	act.interval = time.Millisecond
+
+	return act
+}
+
+func (s *dockerComposeActionSuite) TestShouldRunAllHealthy() {
+	s.mockCompose.EXPECT().IsAvailable().Return(nil)
+	s.mockCompose.EXPECT().Services("").Return([]string{"mysql", "redis"}, nil)
+	s.mockCompose.EXPECT().Ps("").Return([]compose.Container{
+		{Service: "mysql", State: "running", Health: "healthy"},
+		{Service: "redis", State: "running"},
+	}, nil)
+
+	s.Require().False(s.newAction(DockerComposeArgs{}).ShouldRun())
+}
+
+func (s *dockerComposeActionSuite) TestShouldRunWithoutRuntime() {
+	s.mockCompose.EXPECT().IsAvailable().Return(errors.Errorf("No container runtime is running"))
+
+	s.Require().True(s.newAction(DockerComposeArgs{}).ShouldRun())
+}
+
+func (s *dockerComposeActionSuite) TestRunWaitsForHealthchecks() {
+	s.mockCompose.EXPECT().IsAvailable().Return(nil)
+	s.mockCompose.EXPECT().Ps("").Return([]compose.Container{}, nil).Once()
+	s.mockCompose.EXPECT().Up("", []string{"mysql"}).Return(nil)
+	s.mockCompose.EXPECT().Ps("").Return([]compose.Container{{Service: "mysql", State: "running", Health: "starting"}}, nil).Once()
+	s.mockCompose.EXPECT().Ps("").Return([]compose.Container{{Service: "mysql", State: "running", Health: "healthy"}}, nil).Once()
+
+	s.Require().NoError(s.newAction(DockerComposeArgs{Services: []string{"mysql"}}).Run())
+	s.mockCompose.AssertNotCalled(s.T(), "Services", mock.Anything)
+}
+
+func (s *dockerComposeActionSuite) TestOneShotServices() {
+	s.mockCompose.EXPECT().IsAvailable().Return(nil)
+	s.mockCompose.EXPECT().Services("").Return([]string{"mysql", "migrate"}, nil)
+	s.mockCompose.EXPECT().Ps("").Return([]compose.Container{
+		{Service: "mysql", State: "running", Health: "healthy"},
+		{Service: "migrate", State: "exited", ExitCode: 0},
+	}, nil).Once()
+
+	s.Require().False(s.newAction(DockerComposeArgs{}).ShouldRun())
+
+	// after a restart, every container exited
+	s.mockCompose.EXPECT().Ps("").Return([]compose.Container{
+		{Service: "mysql", State: "exited", ExitCode: 0},
+		{Service: "migrate", State: "exited", ExitCode: 0},
+	}, nil).Once()
+	s.mockCompose.EXPECT().Up("", []string(nil)).Return(nil)
+	s.mockCompose.EXPECT().Ps("").Return([]compose.Container{
+		{Service: "mysql", State: "running", Health: "healthy"},
+		{Service: "migrate", State: "exited", ExitCode: 0},
+	}, nil).Once()
+
+	s.Require().NoError(s.newAction(DockerComposeArgs{}).Run())
+}
+
+func (s *dockerComposeActionSuite) TestRunWaitsForOneShotService() {
+	s.mockCompose.EXPECT().IsAvailable().Return(nil)
+	s.mockCompose.EXPECT().Ps("").Return([]compose.Container{{Service: "create-buckets", State: "exited", ExitCode: 0}}, nil).Once()
+	s.mockCompose.EXPECT().Up("", []string{"create-buckets"}).Return(nil)
+	s.mockCompose.EXPECT().Ps("").Return([]compose.Container{{Service: "create-buckets", State: "exited", ExitCode: 0}}, nil).Once()
+
+	s.Require().NoError(s.newAction(DockerComposeArgs{Services: []string{"create-buckets"}}).Run())
+}
+
+func (s *dockerComposeActionSuite) TestRunReportsFailedOneShotService() {
+	s.mockCompose.EXPECT().IsAvailable().Return(nil)
+	s.mockCompose.EXPECT().Services("").Return([]string{"mysql", "migrate"}, nil)
+	s.mockCompose.EXPECT().Ps("").Return([]compose.Container{
+		{Service: "mysql", State: "running"},
+		{Service: "migrate", State: "exited", ExitCode: 1},
+	}, nil)
+	s.mockCompose.EXPECT().Up("", []string(nil)).Return(nil)
+
+	err := s.newAction(DockerComposeArgs{Timeout: time.Millisecond}).Run()
+
+	s.Require().ErrorContains(err, "Compose services migrate were not healthy after 1ms")
+}
+
+func (s *dockerComposeActionSuite) TestRunTimesOut() {
+	s.mockCompose.EXPECT().IsAvailable().Return(nil)
+	s.mockCompose.EXPECT().Services("compose.yml").Return([]string{"elasticsearch"}, nil)
+	s.mockCompose.EXPECT().Ps("compose.yml").Return([]compose.Container{{Service: "elasticsearch", State: "running", Health: "unhealthy"}}, nil)
+	s.mockCompose.EXPECT().Up("compose.yml", []string(nil)).Return(nil)
+
+	err := s.newAction(DockerComposeArgs{File: "compose.yml", Timeout: time.Millisecond}).Run()
+
+	s.Require().ErrorContains(err, "Compose services elasticsearch were not healthy after 1ms")
+}
+
+func TestDockerComposeActionSuite(t *testing.T) {
+	suite.Run(t, new(dockerComposeActionSuite))
+}
@@ -0,0 +1,289 @@
+package actions
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/dotenv"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/prompt"
+	"github.com/renegumroad/gum-cli/internal/secrets"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+)
+
+const (
+	defaultDotenvTemplate = ".env.example"
+	defaultDotenvOutput   = ".env"
+)
+
+// DotenvArgs renders Output from Template. Required keys are asked for when
+// they are missing, and Secrets are generated randomly.
+type DotenvArgs struct {
+	Template string   `yaml:"template,omitempty"`
+	Output   string   `yaml:"output,omitempty"`
+	Required []string `yaml:"required,omitempty"`
+	Secrets  []string `yaml:"secrets,omitempty"`
+}
+
+// DotenvAction renders a dotenv file. Keys of the template declared in the
+// secrets of gum.yml are resolved with their secrets provider.
+type DotenvAction struct {
+	source     string
+	args       DotenvArgs
+	references map[string]string
+	fs         filesystem.Client
+	prompt     prompt.Client
+	secrets    secrets.Client
+	random     func() (string, error)
+}
+
+func NewDotenvAction(source string, args *DotenvArgs, references map[string]string) *DotenvAction {
+	return newDotenvActionWithComponents(source, *args, references, filesystem.New(), prompt.New(), secrets.New(), randomSecret)
+}
+
+func newDotenvActionWithComponents(
+	source string,
+	args DotenvArgs,
+	references map[string]string,
+	fs filesystem.Client,
+	promptClient prompt.Client,
+	secretsClient secrets.Client,
+	random func() (string, error),
+) *DotenvAction {
+	if args.Template == "" {
+		args.Template = defaultDotenvTemplate
+	}
+	if args.Output == "" {
+		args.Output = defaultDotenvOutput
+	}
+
+	return &DotenvAction{
+		source:     source,
+		args:       args,
+		references: references,
+		fs:         fs,
+		prompt:     promptClient,
+		secrets:    secretsClient,
+		random:     random,
+	}
+}
+
+func (a *DotenvAction) Name() string {
+	return "dotenv"
+}
+
+func (a *DotenvAction) Identifier() string {
+	return "dotenv-" + a.args.Output
+}
+
+func (a *DotenvAction) IsPublic() bool {
+	return true
+}
+
+func (a *DotenvAction) Platforms() []systeminfo.Platform {
+	return []systeminfo.Platform{systeminfo.Darwin, systeminfo.Linux}
+}
+
+func (a *DotenvAction) Deps() []Action {
+	return []Action{}
+}
+
+func (a *DotenvAction) Validate() error {
+	for _, key := range a.args.Secrets {
+		if slices.Contains(a.args.Required, key) {
+			return errors.Errorf("%s: %s cannot be both required and a generated secret", a.source, key)
+		}
+	}
+
+	return nil
+}
+
+// ShouldRun reports whether the output is missing keys of the template,
+// listing the new ones.
+func (a *DotenvAction) ShouldRun() bool {
+	template, current, err := a.read()
+	if err != nil {
+		log.Debugf("%s", err)
+		return true
+	}
+
+	if current == nil {
+		return true
+	}
+
+	missing := a.missingKeys(template, current)
+	if len(missing) > 0 {
+		log.Infof("%s is missing keys of %s: %s", a.args.Output, a.args.Template, strings.Join(missing, ", "))
+		return true
+	}
+
+	return false
+}
+
+// Run writes the output, keeping its current lines and adding the keys of
+// the template it is missing.
+func (a *DotenvAction) Run() error {
+	template, current, err := a.read()
+	if err != nil {
+		return err
+	}
+
+	if current == nil {
+		current = dotenv.Parse("")
+	}
+
+	resolved, err := a.resolveSecrets(a.missingKeys(template, current))
+	if err != nil {
+		return err
+	}
+
+	// only the missing keys are written again, the others keep their line
+	values := map[string]string{}
+	for _, key := range a.missingKeys(template, current) {
+		if value, found := resolved[key]; found {
+			values[key] = value
+			continue
+		}
+
+		value, err := a.newValue(template, key)
+		if err != nil {
+			return err
+		}
+		values[key] = value
+	}
+
+	extra := []string{}
+	for _, key := range current.Keys() {
+		if _, found := template.Value(key); !found && !slices.Contains(extra, key) {
+			extra = append(extra, key)
+		}
+	}
+
+	if len(extra) > 0 {
+		log.Infof("Keeping keys of %s that are not in %s: %s", a.args.Output, a.args.Template, strings.Join(extra, ", "))
+	}
+
+	dir, err := a.fs.CurrentDir()
+	if err != nil {
+		return err
+	}
+
+	// the file holds secrets
+	return a.fs.WritePrivateString(filepath.Join(dir, a.args.Output), dotenv.Render(template, current, values))
+}
+
+// newValue returns the value of a key missing from the output: a generated
+// secret, an answer for required keys, or the value of the template.
+func (a *DotenvAction) newValue(template *dotenv.File, key string) (string, error) {
+	if slices.Contains(a.args.Secrets, key) {
+		log.Infof("Generating a random value for %s", key)
+		return a.random()
+	}
+
+	defaultValue, _ := template.Value(key)
+	if !slices.Contains(a.args.Required, key) {
+		return defaultValue, nil
+	}
+
+	if !a.prompt.IsInteractive() {
+		if defaultValue != "" {
+			log.Infof("Using the default value of %s from %s", key, a.args.Template)
+			return defaultValue, nil
+		}
+
+		return "", errors.Errorf("%s is required in %s. Set it there, or run gum dev up in a terminal to be asked for it", key, a.args.Output)
+	}
+
+	value, err := a.prompt.Ask(key, defaultValue)
+	if err != nil {
+		return "", err
+	}
+
+	if value == "" {
+		return "", errors.Errorf("%s is required in %s", key, a.args.Output)
+	}
+
+	return value, nil
+}
+
+// resolveSecrets resolves the references of the missing keys only, since
+// providers such as 1Password may ask for an unlock.
+func (a *DotenvAction) resolveSecrets(missing []string) (map[string]string, error) {
+	references := map[string]string{}
+	for _, key := range missing {
+		if reference, found := a.references[key]; found {
+			references[key] = reference
+		}
+	}
+
+	if len(references) == 0 {
+		return map[string]string{}, nil
+	}
+
+	return a.secrets.Resolve(references)
+}
+
+// missingKeys returns the keys of the template that the output doesn't have,
+// or has without a value when they are required, as when .env is a copy of
+// .env.example.
+func (a *DotenvAction) missingKeys(template, current *dotenv.File) []string {
+	missing := []string{}
+	for _, key := range template.Keys() {
+		value, found := current.Value(key)
+		if slices.Contains(missing, key) {
+			continue
+		}
+
+		if !found || (value == "" && slices.Contains(a.args.Required, key)) {
+			missing = append(missing, key)
+		}
+	}
+
+	return missing
+}
+
+// read parses the template and the output, which is nil when it doesn't
+// exist yet.
+func (a *DotenvAction) read() (*dotenv.File, *dotenv.File, error) {
+	dir, err := a.fs.CurrentDir()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	templatePath := filepath.Join(dir, a.args.Template)
+	if !a.fs.Exists(templatePath) {
+		return nil, nil, errors.Errorf("%s: template %s does not exist", a.source, a.args.Template)
+	}
+
+	content, err := a.fs.ReadString(templatePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	template := dotenv.Parse(content)
+
+	outputPath := filepath.Join(dir, a.args.Output)
+	if !a.fs.Exists(outputPath) {
+		return template, nil, nil
+	}
+
+	content, err = a.fs.ReadString(outputPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return template, dotenv.Parse(content), nil
+}
+
+func randomSecret() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", errors.Errorf("Failed to generate a secret: %s", err)
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
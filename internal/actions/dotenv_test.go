@@ -0,0 +1,141 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/filesystem/mockfilesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/prompt/mockprompt"
+	"github.com/renegumroad/gum-cli/internal/secrets/mocksecrets"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const dotenvTestTemplate = `# Rails
+RAILS_ENV=development
+SECRET_KEY_BASE=
+STRIPE_KEY=
+PORT=3000
+`
+
+type dotenvActionSuite struct {
+	suite.Suite
+	mockFs      *mockfilesystem.MockClient
+	mockPrompt  *mockprompt.MockClient
+	mockSecrets *mocksecrets.MockClient
+	references  map[string]string
+}
+
+func (s *dotenvActionSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *dotenvActionSuite) SetupTest() {
+	s.mockFs = mockfilesystem.NewMockClient(s.T())
+	s.mockPrompt = mockprompt.NewMockClient(s.T())
+	s.mockSecrets = mocksecrets.NewMockClient(s.T())
+	s.references = nil
+	s.mockFs.EXPECT().CurrentDir().Return("/app", nil).Maybe()
+}
+
+func (s *dotenvActionSuite) newAction(args DotenvArgs) *DotenvAction {
+	random := func() (string, error) {
+		return "f00d", nil
+	}
+
+	return newDotenvActionWithComponents("gum.yml up[0]", args, s.references, s.mockFs, s.mockPrompt, s.mockSecrets, random)
+}
+
+func (s *dotenvActionSuite) withFiles(files map[string]string) {
+	s.mockFs.EXPECT().Exists(mock.Anything).RunAndReturn(func(path string) bool {
+		_, found := files[path]
+		return found
+	}).Maybe()
+
+	for path, content := range files {
+		s.mockFs.EXPECT().ReadString(path).Return(content, nil).Maybe()
+	}
+}
+
+func (s *dotenvActionSuite) TestShouldRunReportsNewKeys() {
+	s.withFiles(map[string]string{
+		"/app/.env.example": dotenvTestTemplate,
+		"/app/.env":         "RAILS_ENV=development\nSECRET_KEY_BASE=abc\nSTRIPE_KEY=sk\nPORT=3000\n",
+	})
+	s.Require().False(s.newAction(DotenvArgs{}).ShouldRun())
+
+	s.SetupTest()
+	s.withFiles(map[string]string{
+		"/app/.env.example": dotenvTestTemplate,
+		"/app/.env":         "RAILS_ENV=development\n",
+	})
+	s.Require().True(s.newAction(DotenvArgs{}).ShouldRun())
+}
+
+func (s *dotenvActionSuite) TestRunPromptsAndGenerates() {
+	s.withFiles(map[string]string{
+		"/app/.env.example": dotenvTestTemplate,
+		"/app/.env":         "RAILS_ENV=test\nLOCAL_ONLY=1\n",
+	})
+	s.mockPrompt.EXPECT().IsInteractive().Return(true)
+	s.mockPrompt.EXPECT().Ask("STRIPE_KEY", "").Return("sk_test_123", nil)
+	s.mockFs.EXPECT().WritePrivateString("/app/.env", "# Rails\nRAILS_ENV=test\nSECRET_KEY_BASE=f00d\nSTRIPE_KEY=sk_test_123\nPORT=3000\nLOCAL_ONLY=1\n").Return(nil)
+
+	err := s.newAction(DotenvArgs{Required: []string{"STRIPE_KEY"}, Secrets: []string{"SECRET_KEY_BASE"}}).Run()
+
+	s.Require().NoError(err)
+}
+
+func (s *dotenvActionSuite) TestRunNonInteractive() {
+	s.withFiles(map[string]string{"/app/config/env.template": dotenvTestTemplate})
+	s.mockPrompt.EXPECT().IsInteractive().Return(false)
+
+	err := s.newAction(DotenvArgs{Template: "config/env.template", Required: []string{"PORT", "STRIPE_KEY"}}).Run()
+
+	s.Require().EqualError(err, "STRIPE_KEY is required in .env. Set it there, or run gum dev up in a terminal to be asked for it")
+}
+
+func (s *dotenvActionSuite) TestRunNonInteractiveUsesDefaults() {
+	s.withFiles(map[string]string{"/app/.env.example": dotenvTestTemplate})
+	s.mockPrompt.EXPECT().IsInteractive().Return(false)
+	s.mockFs.EXPECT().WritePrivateString("/app/.env.local", dotenvTestTemplate).Return(nil)
+
+	s.Require().NoError(s.newAction(DotenvArgs{Output: ".env.local", Required: []string{"PORT"}}).Run())
+}
+
+func (s *dotenvActionSuite) TestRunResolvesMissingSecrets() {
+	s.references = map[string]string{
+		"STRIPE_KEY":   "op://dev/stripe/key",
+		"GITHUB_TOKEN": "env://GITHUB_TOKEN",
+		"RAILS_ENV":    "env://RAILS_ENV",
+	}
+	s.withFiles(map[string]string{
+		"/app/.env.example": dotenvTestTemplate,
+		"/app/.env":         "RAILS_ENV=test\n",
+	})
+	s.mockSecrets.EXPECT().Resolve(map[string]string{"STRIPE_KEY": "op://dev/stripe/key"}).Return(map[string]string{"STRIPE_KEY": "sk_live"}, nil)
+	s.mockFs.EXPECT().WritePrivateString("/app/.env", "# Rails\nRAILS_ENV=test\nSECRET_KEY_BASE=\nSTRIPE_KEY=sk_live\nPORT=3000\n").Return(nil)
+
+	err := s.newAction(DotenvArgs{Required: []string{"STRIPE_KEY"}}).Run()
+
+	s.Require().NoError(err)
+}
+
+func (s *dotenvActionSuite) TestRunKeepsLinesAndPromptsEmptyRequiredKeys() {
+	s.withFiles(map[string]string{
+		"/app/.env.example": dotenvTestTemplate,
+		"/app/.env":         "export RAILS_ENV=development\nSECRET_KEY_BASE='pa$$word'\nSTRIPE_KEY=\nPORT=3000 # web\n",
+	})
+	s.mockPrompt.EXPECT().IsInteractive().Return(true)
+	s.mockPrompt.EXPECT().Ask("STRIPE_KEY", "").Return("sk_test_123", nil)
+	s.mockFs.EXPECT().WritePrivateString("/app/.env", "# Rails\nexport RAILS_ENV=development\nSECRET_KEY_BASE='pa$$word'\nSTRIPE_KEY=sk_test_123\nPORT=3000 # web\n").Return(nil)
+	action := s.newAction(DotenvArgs{Required: []string{"STRIPE_KEY"}})
+
+	s.Require().True(action.ShouldRun())
+	s.Require().NoError(action.Run())
+}
+
+func TestDotenvActionSuite(t *testing.T) {
+	suite.Run(t, new(dotenvActionSuite))
+}
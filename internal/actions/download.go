@@ -0,0 +1,222 @@
+package actions
+
+import (
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
+	"github.com/renegumroad/gum-cli/internal/download"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+	"github.com/renegumroad/gum-cli/internal/templates"
+)
+
+// DownloadArgs installs the binary Name, at Version, in /opt/gumroad/bin.
+// URL and Path are templates of the version, OS and arch. Sha256 holds the
+// checksum of the download of each os/arch, such as darwin/arm64. Path is the
+// binary inside the archive, Name by default.
+type DownloadArgs struct {
+	Name        string            `yaml:"name"`
+	Version     string            `yaml:"version"`
+	URL         string            `yaml:"url"`
+	Sha256      map[string]string `yaml:"sha256"`
+	Archive     string            `yaml:"archive,omitempty"`
+	Path        string            `yaml:"path,omitempty"`
+	VersionArgs []string          `yaml:"version_args,omitempty"`
+}
+
+// downloadTemplateData is what the url and path templates are rendered with.
+type downloadTemplateData struct {
+	Version string
+	OS      string
+	Arch    string
+}
+
+type DownloadAction struct {
+	source   string
+	args     *DownloadArgs
+	fs       filesystem.Client
+	sys      systeminfo.Client
+	download func() (download.Client, error)
+	cmdGen   cmdexec.CmdGenerator
+}
+
+func NewDownloadAction(source string, args *DownloadArgs) *DownloadAction {
+	return newDownloadActionWithComponents(source, args, filesystem.New(), systeminfo.New(), download.New, cmdexec.NewCommandGenerator())
+}
+
+func newDownloadActionWithComponents(
+	source string,
+	args *DownloadArgs,
+	fs filesystem.Client,
+	sys systeminfo.Client,
+	downloadClient func() (download.Client, error),
+	gen cmdexec.CmdGenerator,
+) *DownloadAction {
+	return &DownloadAction{
+		source:   source,
+		args:     args,
+		fs:       fs,
+		sys:      sys,
+		download: downloadClient,
+		cmdGen:   gen,
+	}
+}
+
+func (a *DownloadAction) Name() string {
+	return "download"
+}
+
+func (a *DownloadAction) Identifier() string {
+	return "download-" + a.args.Name
+}
+
+func (a *DownloadAction) IsPublic() bool {
+	return true
+}
+
+func (a *DownloadAction) Platforms() []systeminfo.Platform {
+	return []systeminfo.Platform{systeminfo.Darwin, systeminfo.Linux}
+}
+
+func (a *DownloadAction) Deps() []Action {
+	return []Action{}
+}
+
+func (a *DownloadAction) Validate() error {
+	if a.args.Name == "" || strings.ContainsRune(a.args.Name, '/') {
+		return errors.Errorf("%s: download name is required and must be a file name", a.source)
+	}
+
+	if a.args.Version == "" {
+		return errors.Errorf("%s: the version of %s is required", a.source, a.args.Name)
+	}
+
+	if a.args.URL == "" {
+		return errors.Errorf("%s: the url of %s is required", a.source, a.args.Name)
+	}
+
+	if len(a.args.Sha256) == 0 {
+		return errors.Errorf("%s: the sha256 checksums of %s are required", a.source, a.args.Name)
+	}
+
+	if a.args.Archive != "" && !slices.Contains(download.Formats, a.args.Archive) {
+		return errors.Errorf("%s: unsupported archive %s. Supported: %s", a.source, a.args.Archive, strings.Join(download.Formats, ", "))
+	}
+
+	if a.args.Path != "" && a.args.Archive == "" {
+		return errors.Errorf("%s: path requires an archive", a.source)
+	}
+
+	return nil
+}
+
+func (a *DownloadAction) ShouldRun() bool {
+	binDir, err := a.binDir()
+	if err != nil {
+		return true
+	}
+
+	return !a.hasVersion(filepath.Join(binDir, a.args.Name))
+}
+
+func (a *DownloadAction) Run() error {
+	binDir, err := a.binDir()
+	if err != nil {
+		return err
+	}
+
+	platform := a.platform()
+	checksum, found := a.args.Sha256[platform]
+	if !found {
+		return errors.Errorf("%s: %s has no sha256 for %s", a.source, a.args.Name, platform)
+	}
+
+	url, err := a.render(a.args.URL)
+	if err != nil {
+		return err
+	}
+
+	member := a.args.Name
+	if a.args.Path != "" {
+		if member, err = a.render(a.args.Path); err != nil {
+			return err
+		}
+	}
+
+	client, err := a.download()
+	if err != nil {
+		return err
+	}
+
+	file, err := client.Fetch(url, checksum)
+	if err != nil {
+		return err
+	}
+
+	dest := filepath.Join(binDir, a.args.Name)
+	log.Infof("Installing %s %s in %s", a.args.Name, a.args.Version, dest)
+	if err := client.Install(file, a.args.Archive, member, dest); err != nil {
+		return err
+	}
+
+	if !a.hasVersion(dest) {
+		log.Warnf("%s %s doesn't report version %s. Check version_args in gum.yml", dest, strings.Join(a.versionArgs(), " "), a.args.Version)
+	}
+
+	return nil
+}
+
+// binDir is /opt/gumroad/bin, created by gum init.
+func (a *DownloadAction) binDir() (string, error) {
+	binDir := filepath.Join(a.fs.RootDir(), "opt", "gumroad", "bin")
+	if !a.fs.IsDir(binDir) {
+		return "", errors.Errorf("%s does not exist. Run gum init first", binDir)
+	}
+
+	return binDir, nil
+}
+
+// hasVersion probes the installed binary for the expected version.
+func (a *DownloadAction) hasVersion(path string) bool {
+	if !a.fs.IsExecutable(path) {
+		return false
+	}
+
+	cmd := a.cmdGen(path, a.versionArgs()...)
+	if err := cmd.Run(); err != nil {
+		log.Debugf("Failed to get the version of %s: %s", path, err)
+		return false
+	}
+
+	return strings.Contains(cmd.Stdout()+cmd.Stderr(), a.args.Version)
+}
+
+func (a *DownloadAction) versionArgs() []string {
+	if len(a.args.VersionArgs) > 0 {
+		return a.args.VersionArgs
+	}
+
+	return []string{"--version"}
+}
+
+func (a *DownloadAction) platform() string {
+	return a.sys.CurrentPlatform() + "/" + runtime.GOARCH
+}
+
+func (a *DownloadAction) render(content string) (string, error) {
+	rendered, err := templates.Render(a.args.Name, content, &downloadTemplateData{
+		Version: a.args.Version,
+		OS:      a.sys.CurrentPlatform(),
+		Arch:    runtime.GOARCH,
+	})
+	if err != nil {
+		return "", errors.Errorf("%s: %s", a.source, err)
+	}
+
+	return rendered, nil
+}
@@ -0,0 +1,105 @@
+package actions
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec/fakecmdexec"
+	"github.com/renegumroad/gum-cli/internal/download"
+	"github.com/renegumroad/gum-cli/internal/download/mockdownload"
+	"github.com/renegumroad/gum-cli/internal/filesystem/mockfilesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo/mocksysteminfo"
+	"github.com/stretchr/testify/suite"
+)
+
+type downloadActionSuite struct {
+	suite.Suite
+	mockFs       *mockfilesystem.MockClient
+	mockSys      *mocksysteminfo.MockClient
+	mockDownload *mockdownload.MockClient
+}
+
+func (s *downloadActionSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *downloadActionSuite) SetupTest() {
+	s.mockFs = mockfilesystem.NewMockClient(s.T())
+	s.mockSys = mocksysteminfo.NewMockClient(s.T())
+	s.mockDownload = mockdownload.NewMockClient(s.T())
+	s.mockFs.EXPECT().RootDir().Return("/").Maybe()
+	s.mockFs.EXPECT().IsDir("/opt/gumroad/bin").Return(true).Maybe()
+	s.mockSys.EXPECT().CurrentPlatform().Return("linux").Maybe()
+}
+
+func (s *downloadActionSuite) newAction(args *DownloadArgs, cmds ...fakecmdexec.SettableCommand) *DownloadAction {
+	downloadClient := func() (download.Client, error) {
+		return s.mockDownload, nil
+	}
+
+	return newDownloadActionWithComponents("gum.yml up[0]", args, s.mockFs, s.mockSys, downloadClient, fakecmdexec.NewCmdGenerator(cmds...))
+}
+
+func (s *downloadActionSuite) args() *DownloadArgs {
+	return &DownloadArgs{
+		Name:    "overmind",
+		Version: "2.5.1",
+		URL:     "https://example.com/v{{ .Version }}/overmind-{{ .OS }}-{{ .Arch }}.tar.gz",
+		Sha256:  map[string]string{"linux/" + runtime.GOARCH: "abc123"},
+		Archive: download.FormatTarGz,
+		Path:    "overmind-v{{ .Version }}/overmind",
+	}
+}
+
+func (s *downloadActionSuite) TestValidate() {
+	args := s.args()
+	args.Archive = "rar"
+	s.Require().EqualError(s.newAction(args).Validate(), "gum.yml up[0]: unsupported archive rar. Supported: tar.gz, zip")
+
+	args = s.args()
+	args.Sha256 = nil
+	s.Require().EqualError(s.newAction(args).Validate(), "gum.yml up[0]: the sha256 checksums of overmind are required")
+
+	args = s.args()
+	args.Archive = ""
+	s.Require().EqualError(s.newAction(args).Validate(), "gum.yml up[0]: path requires an archive")
+
+	s.Require().NoError(s.newAction(s.args()).Validate())
+}
+
+func (s *downloadActionSuite) TestShouldRunProbesVersion() {
+	s.mockFs.EXPECT().IsExecutable("/opt/gumroad/bin/overmind").Return(true)
+	current := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{Stdout: "Overmind version 2.5.1\n"})
+	s.Require().False(s.newAction(s.args(), current).ShouldRun())
+	s.Require().Equal([]string{"--version"}, current.Args())
+
+	outdated := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{Stdout: "Overmind version 2.4.0\n"})
+	s.Require().True(s.newAction(s.args(), outdated).ShouldRun())
+}
+
+func (s *downloadActionSuite) TestRunInstalls() {
+	s.mockDownload.EXPECT().Fetch("https://example.com/v2.5.1/overmind-linux-"+runtime.GOARCH+".tar.gz", "abc123").Return("/cache/abc123", nil)
+	s.mockDownload.EXPECT().Install("/cache/abc123", "tar.gz", "overmind-v2.5.1/overmind", "/opt/gumroad/bin/overmind").Return(nil)
+	s.mockFs.EXPECT().IsExecutable("/opt/gumroad/bin/overmind").Return(true)
+	probe := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{Stderr: "2.5.1"})
+
+	s.Require().NoError(s.newAction(s.args(), probe).Run())
+	s.Require().Equal("/opt/gumroad/bin/overmind", probe.Cmd())
+}
+
+func (s *downloadActionSuite) TestRunErrors() {
+	s.mockSys.EXPECT().CurrentPlatform().Unset()
+	s.mockSys.EXPECT().CurrentPlatform().Return("darwin")
+	s.Require().EqualError(s.newAction(s.args()).Run(), "gum.yml up[0]: overmind has no sha256 for darwin/"+runtime.GOARCH)
+
+	s.SetupTest()
+	s.mockDownload.EXPECT().Fetch("https://example.com/v2.5.1/overmind-linux-"+runtime.GOARCH+".tar.gz", "abc123").Return("", errors.New("Checksum mismatch"))
+	s.Require().EqualError(s.newAction(s.args()).Run(), "Checksum mismatch")
+}
+
+func TestDownloadActionSuite(t *testing.T) {
+	suite.Run(t, new(downloadActionSuite))
+}
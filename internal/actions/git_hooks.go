@@ -0,0 +1,374 @@
+package actions
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/git"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+)
+
+const gitHooksMarker = "# Managed by gum git_hooks"
+
+var (
+	// gitHooks are the client side hooks run by git.
+	gitHooks = []string{
+		"applypatch-msg", "pre-applypatch", "post-applypatch", "pre-commit", "pre-merge-commit",
+		"prepare-commit-msg", "commit-msg", "post-commit", "pre-rebase", "post-checkout", "post-merge",
+		"pre-push", "post-rewrite", "push-to-checkout", "reference-transaction", "fsmonitor-watchman",
+	}
+
+	// gitStdinHooks read from stdin. Their input is kept to be passed to
+	// every chained hook.
+	gitStdinHooks = []string{"pre-push", "post-rewrite", "reference-transaction"}
+)
+
+// GitHooksArgs installs the hook scripts of Dir, or Commands keyed by hook
+// name, in the hooks directory of the repository. Existing hooks are kept as
+// <hook>.local and run first. With HooksPath, core.hooksPath is set to Dir
+// instead.
+type GitHooksArgs struct {
+	Dir       string            `yaml:"dir,omitempty"`
+	Commands  map[string]string `yaml:"commands,omitempty"`
+	HooksPath bool              `yaml:"hooks_path,omitempty"`
+}
+
+type GitHooksAction struct {
+	source string
+	args   *GitHooksArgs
+	fs     filesystem.Client
+	git    git.Client
+}
+
+func NewGitHooksAction(source string, args *GitHooksArgs) *GitHooksAction {
+	return newGitHooksActionWithComponents(source, args, filesystem.New(), git.New())
+}
+
+func newGitHooksActionWithComponents(source string, args *GitHooksArgs, fs filesystem.Client, gitClient git.Client) *GitHooksAction {
+	return &GitHooksAction{
+		source: source,
+		args:   args,
+		fs:     fs,
+		git:    gitClient,
+	}
+}
+
+func (a *GitHooksAction) Name() string {
+	return "git_hooks"
+}
+
+func (a *GitHooksAction) Identifier() string {
+	return "git_hooks"
+}
+
+func (a *GitHooksAction) IsPublic() bool {
+	return true
+}
+
+func (a *GitHooksAction) Platforms() []systeminfo.Platform {
+	return []systeminfo.Platform{systeminfo.Darwin, systeminfo.Linux}
+}
+
+func (a *GitHooksAction) Deps() []Action {
+	return []Action{}
+}
+
+func (a *GitHooksAction) Validate() error {
+	if (a.args.Dir == "") == (len(a.args.Commands) == 0) {
+		return errors.Errorf("%s: git_hooks require either a dir or commands", a.source)
+	}
+
+	if a.args.HooksPath && a.args.Dir == "" {
+		return errors.Errorf("%s: hooks_path requires a dir", a.source)
+	}
+
+	for _, name := range sortedKeys(a.args.Commands) {
+		if !slices.Contains(gitHooks, name) {
+			return errors.Errorf("%s: %s is not a git hook", a.source, name)
+		}
+
+		if strings.TrimSpace(a.args.Commands[name]) == "" {
+			return errors.Errorf("%s: the command of the %s hook is empty", a.source, name)
+		}
+	}
+
+	return nil
+}
+
+func (a *GitHooksAction) ShouldRun() bool {
+	dir, err := a.fs.CurrentDir()
+	if err != nil {
+		return true
+	}
+
+	if a.args.HooksPath {
+		hooksPath, err := a.git.Config(dir, "core.hooksPath")
+		return err != nil || hooksPath != a.args.Dir
+	}
+
+	hooks, err := a.outdatedHooks(dir)
+	if err != nil {
+		log.Debugf("%s", err)
+		return true
+	}
+
+	return len(hooks) > 0
+}
+
+func (a *GitHooksAction) Run() error {
+	dir, err := a.fs.CurrentDir()
+	if err != nil {
+		return err
+	}
+
+	if a.args.HooksPath {
+		return a.setHooksPath(dir)
+	}
+
+	hooks, err := a.outdatedHooks(dir)
+	if err != nil {
+		return err
+	}
+
+	repo, err := a.git.Repository(dir)
+	if err != nil {
+		return err
+	}
+
+	if repo.IsWorktree() {
+		log.Debugf("%s is a worktree, installing hooks in %s", dir, repo.HooksDir())
+	}
+
+	if err := a.fs.MkdirAll(repo.HooksDir()); err != nil {
+		return err
+	}
+
+	for _, hook := range hooks {
+		if err := a.install(dir, repo.HooksDir(), hook); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setHooksPath points core.hooksPath to the hooks of the project. git then
+// ignores the hooks of .git/hooks, which cannot be chained from files
+// tracked by the project.
+func (a *GitHooksAction) setHooksPath(dir string) error {
+	previous, err := a.git.Config(dir, "core.hooksPath")
+	if err != nil {
+		return err
+	}
+
+	if previous != "" {
+		log.Warnf("Replacing core.hooksPath %s by %s", previous, a.args.Dir)
+	} else if repo, err := a.git.Repository(dir); err == nil {
+		if existing := a.existingHooks(repo.HooksDir()); len(existing) > 0 {
+			log.Warnf("git no longer runs the %s hooks of %s. Remove hooks_path from gum.yml to chain them instead",
+				strings.Join(existing, ", "), repo.HooksDir())
+		}
+	}
+
+	hooks, err := a.hooks(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, hook := range hooks {
+		path := filepath.Join(dir, a.args.Dir, hook)
+		if !a.fs.IsExecutable(path) {
+			log.Warnf("Making %s executable. Commit its mode with git update-index --chmod=+x", path)
+			if err := a.fs.MakeExecutable(path); err != nil {
+				return err
+			}
+		}
+	}
+
+	log.Infof("Setting core.hooksPath to %s", a.args.Dir)
+	return a.git.SetConfig(dir, "core.hooksPath", a.args.Dir)
+}
+
+// outdatedHooks returns the hooks whose script or copy differ from the
+// ones gum installs.
+func (a *GitHooksAction) outdatedHooks(dir string) ([]string, error) {
+	repo, err := a.git.Repository(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	hooksPath, err := a.git.Config(dir, "core.hooksPath")
+	if err != nil {
+		return nil, err
+	}
+
+	if hooksPath != "" {
+		return nil, errors.Errorf("%s: core.hooksPath is set to %s, so git ignores the hooks of %s. Unset it, or use hooks_path", a.source, hooksPath, repo.HooksDir())
+	}
+
+	hooks, err := a.hooks(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	outdated := []string{}
+	for _, hook := range hooks {
+		upToDate, err := a.isUpToDate(dir, repo.HooksDir(), hook)
+		if err != nil {
+			return nil, err
+		}
+
+		if !upToDate {
+			outdated = append(outdated, hook)
+		}
+	}
+
+	return outdated, nil
+}
+
+func (a *GitHooksAction) isUpToDate(dir, hooksDir, hook string) (bool, error) {
+	target := filepath.Join(hooksDir, hook)
+	if !a.fs.IsExecutable(target) {
+		return false, nil
+	}
+
+	content, err := a.fs.ReadString(target)
+	if err != nil {
+		return false, err
+	}
+
+	if content != a.wrapper(hook) {
+		return false, nil
+	}
+
+	if a.args.Dir == "" {
+		return true, nil
+	}
+
+	script := filepath.Join(hooksDir, hook+".gum")
+	if !a.fs.IsExecutable(script) {
+		return false, nil
+	}
+
+	return a.fs.EqualFiles(filepath.Join(dir, a.args.Dir, hook), script)
+}
+
+func (a *GitHooksAction) install(dir, hooksDir, hook string) error {
+	target := filepath.Join(hooksDir, hook)
+
+	if a.fs.Exists(target) {
+		content, err := a.fs.ReadString(target)
+		if err != nil {
+			return err
+		}
+
+		if !strings.Contains(content, gitHooksMarker) {
+			local := target + ".local"
+			if a.fs.Exists(local) {
+				return errors.Errorf("%s: cannot chain the %s hook, %s already exists", a.source, hook, local)
+			}
+
+			log.Infof("Chaining the existing %s hook, moved to %s", hook, local)
+			if err := a.fs.Rename(target, local); err != nil {
+				return err
+			}
+		}
+	}
+
+	if a.args.Dir != "" {
+		script := filepath.Join(hooksDir, hook+".gum")
+		if err := a.fs.CopyFile(filepath.Join(dir, a.args.Dir, hook), script); err != nil {
+			return errors.Errorf("Failed to copy the %s hook: %s", hook, err)
+		}
+
+		if err := a.fs.MakeExecutable(script); err != nil {
+			return err
+		}
+	}
+
+	log.Infof("Installing the %s git hook", hook)
+	if err := a.fs.WriteString(target, a.wrapper(hook)); err != nil {
+		return err
+	}
+
+	return a.fs.MakeExecutable(target)
+}
+
+// hooks returns the hooks to install: the files of Dir named after a git
+// hook, or the hooks of Commands.
+func (a *GitHooksAction) hooks(dir string) ([]string, error) {
+	if a.args.Dir == "" {
+		return sortedKeys(a.args.Commands), nil
+	}
+
+	names, err := a.fs.ListDir(filepath.Join(dir, a.args.Dir))
+	if err != nil {
+		return nil, errors.Errorf("%s: %s", a.source, err)
+	}
+
+	hooks := []string{}
+	for _, name := range names {
+		if !slices.Contains(gitHooks, name) {
+			log.Debugf("Skipping %s, not named after a git hook", name)
+			continue
+		}
+
+		hooks = append(hooks, name)
+	}
+
+	return hooks, nil
+}
+
+func (a *GitHooksAction) existingHooks(hooksDir string) []string {
+	existing := []string{}
+	for _, hook := range gitHooks {
+		if a.fs.IsExecutable(filepath.Join(hooksDir, hook)) {
+			existing = append(existing, hook)
+		}
+	}
+
+	return existing
+}
+
+// wrapper returns the hook installed by gum. It runs the previous hook,
+// <hook>.local, then the copied script <hook>.gum or the configured command,
+// stopping at the first failure.
+func (a *GitHooksAction) wrapper(hook string) string {
+	stdin := slices.Contains(gitStdinHooks, hook)
+	pipe := ""
+	if stdin {
+		pipe = "gum_input | "
+	}
+
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "#!/bin/sh\n%s. Changes are overwritten by gum dev up.\n", gitHooksMarker)
+	fmt.Fprintf(b, "hooks_dir=$(dirname \"$0\")\n")
+	if stdin {
+		fmt.Fprintf(b, "input=$(cat)\ngum_input() { [ -z \"$input\" ] || printf '%%s\\n' \"$input\"; }\n")
+	}
+
+	fmt.Fprintf(b, "\nif [ -x \"$hooks_dir/%s.local\" ]; then\n  %s\"$hooks_dir/%s.local\" \"$@\" || exit $?\nfi\n\n", hook, pipe, hook)
+
+	if a.args.Dir != "" {
+		fmt.Fprintf(b, "%s\"$hooks_dir/%s.gum\" \"$@\"\n", pipe, hook)
+	} else {
+		fmt.Fprintf(b, "%s(\n%s\n)\n", pipe, strings.TrimSpace(a.args.Commands[hook]))
+	}
+
+	return b.String()
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := []string{}
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
@@ -0,0 +1,133 @@
+package actions
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/cli/git"
+	"github.com/renegumroad/gum-cli/internal/cli/git/mockgit"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+// workDirFileSystem is the real filesystem, in a test project directory
+// and home directory.
+type workDirFileSystem struct {
+	filesystem.Client
+	dir     string
+	homeDir string
+}
+
+func (f *workDirFileSystem) CurrentDir() (string, error) {
+	return f.dir, nil
+}
+
+func (f *workDirFileSystem) HomeDir() (string, error) {
+	return f.homeDir, nil
+}
+
+type gitHooksActionSuite struct {
+	suite.Suite
+	dir      string
+	hooksDir string
+	mockGit  *mockgit.MockClient
+}
+
+func (s *gitHooksActionSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *gitHooksActionSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+	s.hooksDir = filepath.Join(s.dir, ".git", "hooks")
+	s.mockGit = mockgit.NewMockClient(s.T())
+	s.mockGit.EXPECT().Repository(s.dir).Return(&git.Repository{
+		GitDir:    filepath.Join(s.dir, ".git"),
+		CommonDir: filepath.Join(s.dir, ".git"),
+	}, nil).Maybe()
+}
+
+func (s *gitHooksActionSuite) newAction(args *GitHooksArgs) *GitHooksAction {
+	return newGitHooksActionWithComponents("gum.yml up[0]", args, &workDirFileSystem{Client: filesystem.New(), dir: s.dir}, s.mockGit)
+}
+
+func (s *gitHooksActionSuite) writeFile(path, content string) {
+	s.Require().NoError(os.MkdirAll(filepath.Dir(path), 0755))
+	s.Require().NoError(os.WriteFile(path, []byte(content), 0755))
+}
+
+func (s *gitHooksActionSuite) runHook(hook, stdin string, args ...string) string {
+	cmd := exec.Command("sh", append([]string{filepath.Join(s.hooksDir, hook)}, args...)...)
+	cmd.Dir = s.dir
+	cmd.Stdin = strings.NewReader(stdin)
+
+	out, err := cmd.CombinedOutput()
+	s.Require().NoError(err, string(out))
+
+	return string(out)
+}
+
+func (s *gitHooksActionSuite) TestValidate() {
+	s.Require().EqualError(s.newAction(&GitHooksArgs{}).Validate(), "gum.yml up[0]: git_hooks require either a dir or commands")
+	s.Require().EqualError(s.newAction(&GitHooksArgs{Commands: map[string]string{"pre-comit": "bin/lint"}}).Validate(), "gum.yml up[0]: pre-comit is not a git hook")
+	s.Require().EqualError(s.newAction(&GitHooksArgs{Commands: map[string]string{"pre-commit": "make"}, HooksPath: true}).Validate(), "gum.yml up[0]: hooks_path requires a dir")
+	s.Require().NoError(s.newAction(&GitHooksArgs{Dir: ".githooks"}).Validate())
+}
+
+func (s *gitHooksActionSuite) TestRunChainsExistingHooks() {
+	s.mockGit.EXPECT().Config(s.dir, "core.hooksPath").Return("", nil)
+	s.writeFile(filepath.Join(s.dir, ".githooks", "pre-push"), "#!/bin/sh\necho \"project $1: $(cat)\"\n")
+	s.writeFile(filepath.Join(s.dir, ".githooks", "README.md"), "Project hooks\n")
+	s.writeFile(filepath.Join(s.hooksDir, "pre-push"), "#!/bin/sh\necho \"local $1: $(cat)\"\n")
+	action := s.newAction(&GitHooksArgs{Dir: ".githooks"})
+
+	s.Require().True(action.ShouldRun())
+	s.Require().NoError(action.Run())
+	s.Require().False(action.ShouldRun())
+
+	s.Require().Equal("local origin: refs/heads/main abc\nproject origin: refs/heads/main abc\n", s.runHook("pre-push", "refs/heads/main abc\n", "origin"))
+	s.Require().NoFileExists(filepath.Join(s.hooksDir, "README.md"))
+
+	s.writeFile(filepath.Join(s.dir, ".githooks", "pre-push"), "#!/bin/sh\necho updated\n")
+	s.Require().True(action.ShouldRun())
+	s.Require().NoError(action.Run())
+	s.Require().Equal("local origin: \nupdated\n", s.runHook("pre-push", "", "origin"))
+}
+
+func (s *gitHooksActionSuite) TestRunCommands() {
+	s.mockGit.EXPECT().Config(s.dir, "core.hooksPath").Return("", nil)
+	action := s.newAction(&GitHooksArgs{Commands: map[string]string{"pre-commit": "echo lint\necho test"}})
+
+	s.Require().NoError(action.Run())
+
+	s.Require().Equal("lint\ntest\n", s.runHook("pre-commit", ""))
+	s.Require().NoFileExists(filepath.Join(s.hooksDir, "pre-commit.local"))
+	s.Require().False(action.ShouldRun())
+}
+
+func (s *gitHooksActionSuite) TestRunRejectsHooksPath() {
+	s.mockGit.EXPECT().Config(s.dir, "core.hooksPath").Return(".husky", nil)
+
+	err := s.newAction(&GitHooksArgs{Commands: map[string]string{"pre-commit": "make lint"}}).Run()
+
+	s.Require().ErrorContains(err, "core.hooksPath is set to .husky")
+}
+
+func (s *gitHooksActionSuite) TestRunSetsHooksPath() {
+	s.writeFile(filepath.Join(s.dir, ".githooks", "pre-commit"), "#!/bin/sh\n")
+	s.mockGit.EXPECT().Config(s.dir, "core.hooksPath").Return("", nil).Times(2)
+	s.mockGit.EXPECT().SetConfig(s.dir, "core.hooksPath", ".githooks").Return(nil)
+	action := s.newAction(&GitHooksArgs{Dir: ".githooks", HooksPath: true})
+
+	s.Require().True(action.ShouldRun())
+	s.Require().NoError(action.Run())
+}
+
+func TestGitHooksActionSuite(t *testing.T) {
+	suite.Run(t, new(gitHooksActionSuite))
+}
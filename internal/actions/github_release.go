@@ -0,0 +1,95 @@
+package actions
+
+import (
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/releases"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+)
+
+// GitHubReleaseArgs installs a binary published in the releases of the
+// GitHub repository Repo, like gum install. Without Version, the latest
+// release is installed once and kept. Asset is a glob of the asset name,
+// templated with .Version, .OS and .Arch, for assets gum can't pick. Path is
+// the binary inside the archive, the repository name by default.
+type GitHubReleaseArgs struct {
+	Repo    string `yaml:"repo"`
+	Version string `yaml:"version,omitempty"`
+	Name    string `yaml:"name,omitempty"`
+	Asset   string `yaml:"asset,omitempty"`
+	Path    string `yaml:"path,omitempty"`
+}
+
+func (args *GitHubReleaseArgs) spec() *releases.Spec {
+	return &releases.Spec{
+		Repo:    args.Repo,
+		Version: args.Version,
+		Name:    args.Name,
+		Asset:   args.Asset,
+		Path:    args.Path,
+	}
+}
+
+type GitHubReleaseAction struct {
+	source   string
+	args     *GitHubReleaseArgs
+	releases func() (releases.Client, error)
+}
+
+func NewGitHubReleaseAction(source string, args *GitHubReleaseArgs) *GitHubReleaseAction {
+	return newGitHubReleaseActionWithComponents(source, args, releases.New)
+}
+
+func newGitHubReleaseActionWithComponents(source string, args *GitHubReleaseArgs, releasesClient func() (releases.Client, error)) *GitHubReleaseAction {
+	return &GitHubReleaseAction{
+		source:   source,
+		args:     args,
+		releases: releasesClient,
+	}
+}
+
+func (a *GitHubReleaseAction) Name() string {
+	return "github_release"
+}
+
+func (a *GitHubReleaseAction) Identifier() string {
+	return "github-release-" + a.args.spec().BinaryName()
+}
+
+func (a *GitHubReleaseAction) IsPublic() bool {
+	return true
+}
+
+func (a *GitHubReleaseAction) Platforms() []systeminfo.Platform {
+	return []systeminfo.Platform{systeminfo.Darwin, systeminfo.Linux}
+}
+
+func (a *GitHubReleaseAction) Deps() []Action {
+	return []Action{}
+}
+
+func (a *GitHubReleaseAction) Validate() error {
+	if err := a.args.spec().Validate(); err != nil {
+		return errors.Errorf("%s: %s", a.source, err)
+	}
+
+	return nil
+}
+
+func (a *GitHubReleaseAction) ShouldRun() bool {
+	client, err := a.releases()
+	if err != nil {
+		return true
+	}
+
+	return !client.IsInstalled(a.args.spec())
+}
+
+func (a *GitHubReleaseAction) Run() error {
+	client, err := a.releases()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Install(a.args.spec())
+	return err
+}
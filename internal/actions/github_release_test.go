@@ -0,0 +1,56 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/releases"
+	"github.com/renegumroad/gum-cli/internal/releases/mockreleases"
+	"github.com/stretchr/testify/suite"
+)
+
+type githubReleaseActionSuite struct {
+	suite.Suite
+	mockReleases *mockreleases.MockClient
+}
+
+func (s *githubReleaseActionSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *githubReleaseActionSuite) SetupTest() {
+	s.mockReleases = mockreleases.NewMockClient(s.T())
+}
+
+func (s *githubReleaseActionSuite) newAction(args *GitHubReleaseArgs) *GitHubReleaseAction {
+	releasesClient := func() (releases.Client, error) {
+		return s.mockReleases, nil
+	}
+
+	return newGitHubReleaseActionWithComponents("gum.yml up[0]", args, releasesClient)
+}
+
+func (s *githubReleaseActionSuite) TestValidate() {
+	s.Require().EqualError(s.newAction(&GitHubReleaseArgs{Repo: "tool"}).Validate(), `gum.yml up[0]: Invalid repository "tool": expected owner/repo`)
+	s.Require().NoError(s.newAction(&GitHubReleaseArgs{Repo: "gumroad/tool"}).Validate())
+}
+
+func (s *githubReleaseActionSuite) TestIdentifier() {
+	s.Require().Equal("github-release-tool", s.newAction(&GitHubReleaseArgs{Repo: "gumroad/tool"}).Identifier())
+	s.Require().Equal("github-release-t", s.newAction(&GitHubReleaseArgs{Repo: "gumroad/tool", Name: "t"}).Identifier())
+}
+
+func (s *githubReleaseActionSuite) TestRun() {
+	spec := &releases.Spec{Repo: "gumroad/tool", Version: "1.2.0"}
+	s.mockReleases.EXPECT().IsInstalled(spec).Return(false)
+	s.mockReleases.EXPECT().Install(spec).Return(&releases.Entry{Repo: "gumroad/tool", Version: "v1.2.0"}, nil)
+	action := s.newAction(&GitHubReleaseArgs{Repo: "gumroad/tool", Version: "1.2.0"})
+
+	s.Require().True(action.ShouldRun())
+	s.Require().NoError(action.Run())
+}
+
+func TestGitHubReleaseActionSuite(t *testing.T) {
+	suite.Run(t, new(githubReleaseActionSuite))
+}
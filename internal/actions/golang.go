@@ -1,15 +1,111 @@
 package actions
 
 import (
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/golang"
 	"github.com/renegumroad/gum-cli/internal/cli/homebrew"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
 	"github.com/renegumroad/gum-cli/internal/systeminfo"
 )
 
+const (
+	ToolchainIgnore  = "ignore"
+	ToolchainWarn    = "warn"
+	ToolchainInstall = "install"
+
+	// minSwitchingGo is the first Go release able to download the toolchain
+	// required by go.mod.
+	minSwitchingGo = "1.21"
+)
+
+var (
+	defaultGolangTools = []string{"goreleaser", "golangci-lint", "go-task", "mockery", "gopls"}
+	toolchainModes     = []string{ToolchainIgnore, ToolchainWarn, ToolchainInstall}
+	toolsFiles         = []string{"tools.go", filepath.Join("tools", "tools.go")}
+
+	majorVersionRe = regexp.MustCompile(`^v\d+$`)
+
+	golangSchema = Schema{
+		{
+			Name:        "tools",
+			Type:        StringListParam,
+			Description: "Brew packages installed along with go. Defaults to goreleaser, golangci-lint, go-task, mockery and gopls.",
+		},
+		{
+			Name:        "toolchain",
+			Type:        StringParam,
+			Description: "What to do when go is older than the go or toolchain directive of go.mod. Defaults to warn.",
+			Values:      toolchainModes,
+		},
+		{
+			Name:        "mod_download",
+			Type:        BoolParam,
+			Description: "Run go mod download. Defaults to true.",
+		},
+		{
+			Name:        "project_tools",
+			Type:        BoolParam,
+			Description: "go install the tool directives of go.mod and the imports of tools.go. Defaults to false.",
+		},
+	}
+)
+
+// GolangArgs configures the golang action. Tools are brew packages installed
+// along with go; when unset a default set of tools is used.
+type GolangArgs struct {
+	Tools        []string `yaml:"tools"`
+	Toolchain    string   `yaml:"toolchain"`
+	ModDownload  *bool    `yaml:"mod_download"`
+	ProjectTools bool     `yaml:"project_tools"`
+}
+
+type goTool struct {
+	pkg     string
+	version string
+}
+
 type GolangAction struct {
+	args   GolangArgs
+	fs     filesystem.Client
+	brew   homebrew.Client
+	golang golang.Client
 }
 
-func NewGolangAction() *GolangAction {
-	return &GolangAction{}
+func NewGolangAction(args GolangArgs) *GolangAction {
+	return newGolangActionWithComponents(args, filesystem.New(), homebrew.Default(), golang.New())
+}
+
+func newGolangActionWithComponents(
+	args GolangArgs,
+	fs filesystem.Client,
+	brew homebrew.Client,
+	goClient golang.Client,
+) *GolangAction {
+	if args.Tools == nil {
+		args.Tools = defaultGolangTools
+	}
+
+	if args.Toolchain == "" {
+		args.Toolchain = ToolchainWarn
+	}
+
+	if args.ModDownload == nil {
+		modDownload := true
+		args.ModDownload = &modDownload
+	}
+
+	return &GolangAction{
+		args:   args,
+		fs:     fs,
+		brew:   brew,
+		golang: goClient,
+	}
 }
 
 func (a *GolangAction) Name() string {
@@ -29,27 +125,265 @@ func (a *GolangAction) Platforms() []systeminfo.Platform {
 }
 
 func (a *GolangAction) Deps() []Action {
+	packages := []homebrew.Package{{Name: "go"}}
+	for _, tool := range a.args.Tools {
+		packages = append(packages, homebrew.Package{Name: tool})
+	}
+
 	return []Action{
-		NewBrewAction(
-			[]homebrew.Package{
-				{Name: "go"},
-				{Name: "goreleaser"},
-				{Name: "golangci-lint"},
-				{Name: "go-task"},
-				{Name: "mockery"},
-				{Name: "gopls"},
-			}),
+		newBrewActionWithClient("action golang", packages, a.brew),
 	}
 }
 
 func (a *GolangAction) Validate() error {
+	if !slices.Contains(toolchainModes, a.args.Toolchain) {
+		return errors.Errorf("Failed %s action validation: invalid toolchain %s. Expected one of %s", a.Name(), a.args.Toolchain, toolchainModes)
+	}
+
+	for _, tool := range a.args.Tools {
+		if tool == "" {
+			return errors.Errorf("Failed %s action validation: tool name is required", a.Name())
+		}
+	}
+
 	return nil
 }
 
 func (a *GolangAction) ShouldRun() bool {
-	return depsShouldRun(a.Deps())
+	return depsShouldRun(a.Deps()) || a.needsSetup()
+}
+
+// needsSetup checks the Go version, the module cache and the project tools
+// against the go.mod of the current directory.
+func (a *GolangAction) needsSetup() bool {
+	mod, err := a.goMod()
+	if err != nil {
+		log.Debugf("Unable to read go.mod: %s", err)
+		return true
+	}
+
+	if mod == nil {
+		return false
+	}
+
+	if a.args.Toolchain == ToolchainInstall && !a.hasRequiredVersion(mod) {
+		return true
+	}
+
+	if *a.args.ModDownload && !a.golang.IsModCacheComplete() {
+		return true
+	}
+
+	if a.args.ProjectTools {
+		tools, err := a.outdatedTools(mod)
+		if err != nil || len(tools) > 0 {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (a *GolangAction) Run() error {
+	mod, err := a.goMod()
+	if err != nil {
+		return err
+	}
+
+	if mod == nil {
+		log.Infof("No go.mod found, skipping Go project setup")
+		return nil
+	}
+
+	if err := a.ensureToolchain(mod); err != nil {
+		return err
+	}
+
+	if *a.args.ModDownload {
+		if a.golang.IsModCacheComplete() {
+			log.Infof("Go modules are already downloaded")
+		} else if err := a.golang.ModDownload(); err != nil {
+			return err
+		}
+	}
+
+	if !a.args.ProjectTools {
+		return nil
+	}
+
+	tools, err := a.outdatedTools(mod)
+	if err != nil {
+		return err
+	}
+
+	for _, tool := range tools {
+		if err := a.golang.Install(tool.pkg, tool.version); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// hasRequiredVersion reports whether go, or the toolchain it switches to for
+// go.mod, satisfies the requirement of go.mod.
+func (a *GolangAction) hasRequiredVersion(mod *golang.GoMod) bool {
+	required := mod.RequiredVersion()
+	if required == "" {
+		return true
+	}
+
+	version, err := a.golang.Version()
+	if err != nil {
+		log.Debugf("%s", err)
+		return false
+	}
+
+	return golang.CompareVersions(version, required) >= 0 || a.golang.HasToolchain(required)
+}
+
+// ensureToolchain compares the Go version with the one go.mod requires. In
+// install mode, an outdated go is upgraded with brew and, when brew's go is
+// still too old, the required toolchain is downloaded by the go command.
+func (a *GolangAction) ensureToolchain(mod *golang.GoMod) error {
+	required := mod.RequiredVersion()
+	if a.args.Toolchain == ToolchainIgnore || required == "" {
+		return nil
+	}
+
+	version, err := a.golang.Version()
+	if err != nil {
+		return err
+	}
+
+	if golang.CompareVersions(version, required) >= 0 {
+		log.Debugf("Go %s satisfies go.mod requirement %s", version, required)
+		return nil
+	}
+
+	if a.args.Toolchain == ToolchainWarn {
+		log.Warnf("Go %s is older than %s required by go.mod", version, required)
+		return nil
+	}
+
+	if golang.CompareVersions(version, minSwitchingGo) < 0 {
+		if err := a.brew.Upgrade(homebrew.Package{Name: "go"}); err != nil {
+			return err
+		}
+
+		if version, err = a.golang.Version(); err != nil {
+			return err
+		}
+
+		if golang.CompareVersions(version, required) >= 0 {
+			return nil
+		}
+	}
+
+	if a.golang.HasToolchain(required) {
+		log.Debugf("Go toolchain %s is already downloaded", required)
+		return nil
+	}
+
+	return a.golang.DownloadToolchain(required)
+}
+
+// outdatedTools returns the project tools, declared with tool directives or
+// in a tools.go file, whose binary is missing or built from another version.
+func (a *GolangAction) outdatedTools(mod *golang.GoMod) ([]goTool, error) {
+	pkgs, err := a.projectTools(mod)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(pkgs) == 0 {
+		return []goTool{}, nil
+	}
+
+	binDir, err := a.golang.BinDir()
+	if err != nil {
+		return nil, err
+	}
+
+	outdated := []goTool{}
+	for _, pkg := range pkgs {
+		version, found := mod.ModuleVersion(pkg)
+		if !found {
+			log.Warnf("No module in go.mod provides tool %s, skipping it", pkg)
+			continue
+		}
+
+		binary := filepath.Join(binDir, binaryName(pkg))
+		if a.fs.Exists(binary) {
+			installed, err := a.golang.InstalledVersion(binary)
+			if err == nil && installed == version {
+				log.Debugf("Tool %s %s is already installed", pkg, version)
+				continue
+			}
+		}
+
+		outdated = append(outdated, goTool{pkg: pkg, version: version})
+	}
+
+	return outdated, nil
+}
+
+func (a *GolangAction) projectTools(mod *golang.GoMod) ([]string, error) {
+	dir, err := a.fs.CurrentDir()
+	if err != nil {
+		return nil, err
+	}
+
+	pkgs := slices.Clone(mod.Tools)
+	for _, name := range toolsFiles {
+		file := filepath.Join(dir, name)
+		if !a.fs.Exists(file) {
+			continue
+		}
+
+		content, err := a.fs.ReadString(file)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pkg := range golang.ParseToolsFile(content) {
+			if !slices.Contains(pkgs, pkg) {
+				pkgs = append(pkgs, pkg)
+			}
+		}
+	}
+
+	return pkgs, nil
+}
+
+// goMod parses the go.mod of the current directory, returning nil when
+// there is none.
+func (a *GolangAction) goMod() (*golang.GoMod, error) {
+	dir, err := a.fs.CurrentDir()
+	if err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(dir, "go.mod")
+	if !a.fs.Exists(path) {
+		return nil, nil
+	}
+
+	content, err := a.fs.ReadString(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return golang.ParseGoMod(content), nil
+}
+
+// binaryName is the name go install gives to the binary of pkg: its last
+// path element, skipping a major version suffix.
+func binaryName(pkg string) string {
+	name := path.Base(pkg)
+	if majorVersionRe.MatchString(name) {
+		name = path.Base(path.Dir(pkg))
+	}
+
+	return name
+}
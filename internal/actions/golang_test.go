@@ -0,0 +1,152 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/cli/golang/mockgolang"
+	"github.com/renegumroad/gum-cli/internal/cli/homebrew"
+	"github.com/renegumroad/gum-cli/internal/cli/homebrew/mockhomebrew"
+	"github.com/renegumroad/gum-cli/internal/filesystem/mockfilesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const golangTestGoMod = `module github.com/acme/api
+
+go 1.22.1
+
+toolchain go1.22.4
+
+require golang.org/x/tools v0.20.0
+`
+
+type golangActionSuite struct {
+	suite.Suite
+	mockFs     *mockfilesystem.MockClient
+	mockBrew   *mockhomebrew.MockClient
+	mockGolang *mockgolang.MockClient
+}
+
+func (s *golangActionSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *golangActionSuite) SetupTest() {
+	s.mockFs = mockfilesystem.NewMockClient(s.T())
+	s.mockBrew = mockhomebrew.NewMockClient(s.T())
+	s.mockGolang = mockgolang.NewMockClient(s.T())
+	s.mockFs.EXPECT().CurrentDir().Return("/app", nil).Maybe()
+}
+
+func (s *golangActionSuite) newAction(args GolangArgs) *GolangAction {
+	return newGolangActionWithComponents(args, s.mockFs, s.mockBrew, s.mockGolang)
+}
+
+func (s *golangActionSuite) withFiles(files map[string]string) {
+	s.mockFs.EXPECT().Exists(mock.Anything).RunAndReturn(func(path string) bool {
+		_, found := files[path]
+		return found
+	}).Maybe()
+
+	for path, content := range files {
+		s.mockFs.EXPECT().ReadString(path).Return(content, nil).Maybe()
+	}
+}
+
+func (s *golangActionSuite) TestDepsDefaultTools() {
+	deps := s.newAction(GolangArgs{}).Deps()
+
+	s.Require().Len(deps, 1)
+	s.Require().Equal("brew-go-goreleaser-golangci-lint-go-task-mockery-gopls", deps[0].Identifier())
+}
+
+func (s *golangActionSuite) TestDepsConfiguredTools() {
+	act, err := NewNamedAction("golang", map[string]interface{}{"tools": []interface{}{}})
+	s.Require().NoError(err)
+
+	deps := act.Deps()
+
+	s.Require().Len(deps, 1)
+	s.Require().Equal("brew-go", deps[0].Identifier())
+}
+
+func (s *golangActionSuite) TestValidateToolchain() {
+	s.Require().ErrorContains(s.newAction(GolangArgs{Toolchain: "always"}).Validate(), "invalid toolchain always")
+}
+
+func (s *golangActionSuite) TestNeedsSetupWithoutGoMod() {
+	s.withFiles(map[string]string{})
+
+	s.Require().False(s.newAction(GolangArgs{}).needsSetup())
+}
+
+func (s *golangActionSuite) TestNeedsSetupModCacheIncomplete() {
+	s.withFiles(map[string]string{"/app/go.mod": golangTestGoMod})
+	s.mockGolang.EXPECT().IsModCacheComplete().Return(false)
+
+	s.Require().True(s.newAction(GolangArgs{}).needsSetup())
+}
+
+func (s *golangActionSuite) TestRunWarnsOnOldToolchain() {
+	s.withFiles(map[string]string{"/app/go.mod": golangTestGoMod})
+	s.mockGolang.EXPECT().Version().Return("1.22.1", nil)
+	s.mockGolang.EXPECT().IsModCacheComplete().Return(false)
+	s.mockGolang.EXPECT().ModDownload().Return(nil)
+
+	s.Require().NoError(s.newAction(GolangArgs{}).Run())
+	s.mockGolang.AssertNotCalled(s.T(), "DownloadToolchain", mock.Anything)
+}
+
+func (s *golangActionSuite) TestRunInstallsToolchain() {
+	modDownload := false
+	s.withFiles(map[string]string{"/app/go.mod": golangTestGoMod})
+	s.mockGolang.EXPECT().Version().Return("1.22.1", nil)
+	s.mockGolang.EXPECT().HasToolchain("1.22.4").Return(false).Once()
+	s.mockGolang.EXPECT().DownloadToolchain("1.22.4").Return(nil)
+	action := s.newAction(GolangArgs{Toolchain: ToolchainInstall, ModDownload: &modDownload})
+
+	s.Require().NoError(action.Run())
+
+	s.mockGolang.EXPECT().HasToolchain("1.22.4").Return(true)
+	s.Require().False(action.needsSetup())
+	s.Require().NoError(action.Run())
+	s.mockGolang.AssertNumberOfCalls(s.T(), "DownloadToolchain", 1)
+}
+
+func (s *golangActionSuite) TestRunUpgradesBrewGoBeforeToolchainSwitching() {
+	modDownload := false
+	s.withFiles(map[string]string{"/app/go.mod": golangTestGoMod})
+	s.mockGolang.EXPECT().Version().Return("1.20.5", nil).Once()
+	s.mockBrew.EXPECT().Upgrade(homebrew.Package{Name: "go"}).Return(nil)
+	s.mockGolang.EXPECT().Version().Return("1.23.0", nil).Once()
+
+	s.Require().NoError(s.newAction(GolangArgs{Toolchain: ToolchainInstall, ModDownload: &modDownload}).Run())
+	s.mockGolang.AssertNotCalled(s.T(), "DownloadToolchain", mock.Anything)
+}
+
+func (s *golangActionSuite) TestRunInstallsOutdatedProjectTools() {
+	modDownload := false
+	s.withFiles(map[string]string{
+		"/app/go.mod":                golangTestGoMod,
+		"/app/tools.go":              "//go:build tools\n\npackage tools\n\nimport (\n\t_ \"golang.org/x/tools/cmd/stringer\"\n\t_ \"golang.org/x/tools/cmd/goimports\"\n)\n",
+		"/home/dev/go/bin/goimports": "",
+	})
+	s.mockGolang.EXPECT().Version().Return("1.22.4", nil)
+	s.mockGolang.EXPECT().BinDir().Return("/home/dev/go/bin", nil)
+	s.mockGolang.EXPECT().InstalledVersion("/home/dev/go/bin/goimports").Return("v0.20.0", nil)
+	s.mockGolang.EXPECT().Install("golang.org/x/tools/cmd/stringer", "v0.20.0").Return(nil)
+
+	s.Require().NoError(s.newAction(GolangArgs{ProjectTools: true, ModDownload: &modDownload}).Run())
+	s.mockGolang.AssertNumberOfCalls(s.T(), "Install", 1)
+}
+
+func (s *golangActionSuite) TestBinaryName() {
+	s.Require().Equal("stringer", binaryName("golang.org/x/tools/cmd/stringer"))
+	s.Require().Equal("mockery", binaryName("github.com/vektra/mockery/v2"))
+}
+
+func TestGolangActionSuite(t *testing.T) {
+	suite.Run(t, new(golangActionSuite))
+}
@@ -0,0 +1,103 @@
+package actions
+
+import (
+	"slices"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/hostsfile"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+)
+
+// HostsAction keeps the entries of a project in a block of the hosts file
+// named after the project directory. Every hosts entry of gum.yml is merged
+// into the same block. Without entries, it removes the block left by hosts
+// entries deleted from gum.yml.
+type HostsAction struct {
+	source  string
+	entries []hostsfile.Entry
+	fs      filesystem.Client
+	hosts   hostsfile.Client
+}
+
+func NewHostsAction(source string, entries []hostsfile.Entry) *HostsAction {
+	return newHostsActionWithComponents(source, entries, filesystem.New(), hostsfile.New())
+}
+
+func newHostsActionWithComponents(source string, entries []hostsfile.Entry, fs filesystem.Client, hosts hostsfile.Client) *HostsAction {
+	return &HostsAction{
+		source:  source,
+		entries: entries,
+		fs:      fs,
+		hosts:   hosts,
+	}
+}
+
+func (a *HostsAction) Name() string {
+	return "hosts"
+}
+
+func (a *HostsAction) Identifier() string {
+	return "hosts"
+}
+
+func (a *HostsAction) IsPublic() bool {
+	return true
+}
+
+func (a *HostsAction) Platforms() []systeminfo.Platform {
+	return []systeminfo.Platform{systeminfo.Darwin, systeminfo.Linux}
+}
+
+func (a *HostsAction) Deps() []Action {
+	return []Action{}
+}
+
+func (a *HostsAction) merged(other Action) Action {
+	entries := append([]hostsfile.Entry{}, a.entries...)
+
+	return &HostsAction{
+		source:  a.source,
+		entries: append(entries, other.(*HostsAction).entries...),
+		fs:      a.fs,
+		hosts:   a.hosts,
+	}
+}
+
+func (a *HostsAction) Validate() error {
+	for _, entry := range a.entries {
+		if err := entry.Validate(); err != nil {
+			return errors.Errorf("%s: %s", a.source, err)
+		}
+	}
+
+	return nil
+}
+
+func (a *HostsAction) ShouldRun() bool {
+	owner, err := a.fs.CurrentDir()
+	if err != nil {
+		return true
+	}
+
+	current, err := a.hosts.Entries(owner)
+	if err != nil {
+		log.Debugf("Unable to read the hosts file: %s", err)
+		// there is no block to remove from a file that can't be read
+		return len(a.entries) > 0
+	}
+
+	return !slices.EqualFunc(current, a.entries, func(x, y hostsfile.Entry) bool {
+		return x.IP == y.IP && slices.Equal(x.Names, y.Names)
+	})
+}
+
+func (a *HostsAction) Run() error {
+	owner, err := a.fs.CurrentDir()
+	if err != nil {
+		return err
+	}
+
+	return a.hosts.Update(owner, a.entries)
+}
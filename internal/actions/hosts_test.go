@@ -0,0 +1,77 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/filesystem/mockfilesystem"
+	"github.com/renegumroad/gum-cli/internal/hostsfile"
+	"github.com/renegumroad/gum-cli/internal/hostsfile/mockhostsfile"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type hostsActionSuite struct {
+	suite.Suite
+	mockFs    *mockfilesystem.MockClient
+	mockHosts *mockhostsfile.MockClient
+}
+
+func (s *hostsActionSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *hostsActionSuite) SetupTest() {
+	s.mockFs = mockfilesystem.NewMockClient(s.T())
+	s.mockHosts = mockhostsfile.NewMockClient(s.T())
+	s.mockFs.EXPECT().CurrentDir().Return("/app", nil).Maybe()
+}
+
+func (s *hostsActionSuite) newAction(source string, entries ...hostsfile.Entry) *HostsAction {
+	return newHostsActionWithComponents(source, entries, s.mockFs, s.mockHosts)
+}
+
+func (s *hostsActionSuite) TestShouldRun() {
+	entry := hostsfile.Entry{IP: "127.0.0.1", Names: []string{"app.gumroad.dev"}}
+	s.mockHosts.EXPECT().Entries("/app").Return([]hostsfile.Entry{entry}, nil)
+
+	s.Require().False(s.newAction("gum.yml up[0]", entry).ShouldRun())
+	s.Require().True(s.newAction("gum.yml up[0]", entry, hostsfile.Entry{IP: "::1", Names: []string{"app.gumroad.dev"}}).ShouldRun())
+}
+
+func (s *hostsActionSuite) TestRemovesBlockWithoutEntries() {
+	s.mockHosts.EXPECT().Entries("/app").Return([]hostsfile.Entry{{IP: "127.0.0.1", Names: []string{"app.gumroad.dev"}}}, nil).Once()
+	s.mockHosts.EXPECT().Update("/app", []hostsfile.Entry(nil)).Return(nil)
+	action := s.newAction("gum.yml")
+
+	s.Require().True(action.ShouldRun())
+	s.Require().NoError(action.Run())
+
+	s.mockHosts.EXPECT().Entries("/app").Return([]hostsfile.Entry{}, nil).Once()
+	s.Require().False(action.ShouldRun())
+
+	s.mockHosts.EXPECT().Entries("/app").Return(nil, errors.New("permission denied")).Once()
+	s.Require().False(action.ShouldRun())
+}
+
+func (s *hostsActionSuite) TestMergedEntriesShareTheBlock() {
+	app := hostsfile.Entry{IP: "127.0.0.1", Names: []string{"app.gumroad.dev"}}
+	api := hostsfile.Entry{IP: "127.0.0.1", Names: []string{"api.gumroad.dev"}}
+	s.mockHosts.EXPECT().Update("/app", []hostsfile.Entry{app, api}).Return(nil)
+
+	actions := mergeActions([]Action{s.newAction("gum.yml up[0]", app), s.newAction("gum.yml up[2]", api)})
+
+	s.Require().Len(actions, 1)
+	s.Require().NoError(actions[0].Run())
+}
+
+func (s *hostsActionSuite) TestValidate() {
+	err := s.newAction("gum.yml up[1]", hostsfile.Entry{IP: "127.0.0.1", Names: []string{"app_gumroad"}}).Validate()
+
+	s.Require().EqualError(err, `gum.yml up[1]: Invalid hostname "app_gumroad" in hosts entry for 127.0.0.1`)
+}
+
+func TestHostsActionSuite(t *testing.T) {
+	suite.Run(t, new(hostsActionSuite))
+}
@@ -0,0 +1,225 @@
+package actions
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/homebrew"
+	"github.com/renegumroad/gum-cli/internal/cli/java"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/shellmanager"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+)
+
+// javaShellConfig is the name of the snippet exporting JAVA_HOME.
+const javaShellConfig = "java"
+
+var javaSchema = Schema{
+	{
+		Name:        "version",
+		Type:        IntParam,
+		Description: "Major JDK version, such as 17. Takes precedence over .java-version and .sdkmanrc.",
+	},
+}
+
+// JavaArgs configures the java action.
+type JavaArgs struct {
+	Version int `yaml:"version"`
+}
+
+type JavaAction struct {
+	args  JavaArgs
+	fs    filesystem.Client
+	sys   systeminfo.Client
+	java  java.Client
+	shell shellmanager.Client
+}
+
+func NewJavaAction(args JavaArgs) *JavaAction {
+	return newJavaActionWithComponents(args, filesystem.New(), systeminfo.New(), java.New(), shellmanager.New())
+}
+
+func newJavaActionWithComponents(
+	args JavaArgs,
+	fs filesystem.Client,
+	sys systeminfo.Client,
+	javaClient java.Client,
+	shell shellmanager.Client,
+) *JavaAction {
+	return &JavaAction{
+		args:  args,
+		fs:    fs,
+		sys:   sys,
+		java:  javaClient,
+		shell: shell,
+	}
+}
+
+func (a *JavaAction) Name() string {
+	return "java"
+}
+
+func (a *JavaAction) Identifier() string {
+	return "java"
+}
+
+func (a *JavaAction) IsPublic() bool {
+	return true
+}
+
+func (a *JavaAction) Platforms() []systeminfo.Platform {
+	return []systeminfo.Platform{systeminfo.Darwin, systeminfo.Linux}
+}
+
+// Deps installs the Temurin cask on macOS and the OpenJDK package of the
+// distribution on Linux.
+func (a *JavaAction) Deps() []Action {
+	major, err := a.requiredVersion()
+	if err != nil {
+		return []Action{}
+	}
+
+	if a.sys.IsMacOS() {
+		return []Action{
+			NewBrewAction("action java", []homebrew.Package{{Name: fmt.Sprintf("temurin@%d", major), Cask: true}}),
+		}
+	}
+
+	return []Action{
+		NewSystemPackagesAction(&SystemPackagesArgs{
+			Apt:    []string{fmt.Sprintf("openjdk-%d-jdk", major)},
+			Dnf:    []string{fmt.Sprintf("java-%d-openjdk-devel", major)},
+			Pacman: []string{fmt.Sprintf("jdk%d-openjdk", major)},
+		}),
+	}
+}
+
+func (a *JavaAction) Validate() error {
+	_, err := a.requiredVersion()
+	return err
+}
+
+func (a *JavaAction) ShouldRun() bool {
+	return depsShouldRun(a.Deps()) || a.needsSetup()
+}
+
+// needsSetup checks that the JDK reports the required version and that the
+// shell config exports it as JAVA_HOME.
+func (a *JavaAction) needsSetup() bool {
+	major, err := a.requiredVersion()
+	if err != nil {
+		log.Debugf("Unable to read the java version: %s", err)
+		return true
+	}
+
+	home, err := a.checkedHome(major)
+	if err != nil {
+		log.Debugf("%s", err)
+		return true
+	}
+
+	current, err := a.shell.ReadManagedConfig(javaShellConfig)
+	if err != nil {
+		log.Debugf("Unable to read the java shell config: %s", err)
+		return true
+	}
+
+	return current != javaShellSnippet(home)
+}
+
+func (a *JavaAction) Run() error {
+	major, err := a.requiredVersion()
+	if err != nil {
+		return err
+	}
+
+	home, err := a.checkedHome(major)
+	if err != nil {
+		return err
+	}
+
+	current, err := a.shell.ReadManagedConfig(javaShellConfig)
+	if err != nil {
+		return err
+	}
+
+	snippet := javaShellSnippet(home)
+	if current == snippet {
+		log.Infof("JDK %d is already set up", major)
+		return nil
+	}
+
+	log.Infof("Exporting JAVA_HOME=%s, open a new shell to use it", home)
+
+	return a.shell.WriteManagedConfig(javaShellConfig, snippet)
+}
+
+// checkedHome returns the home of the required JDK after checking the
+// version its java command reports.
+func (a *JavaAction) checkedHome(major int) (string, error) {
+	home, err := a.java.Home(major)
+	if err != nil {
+		return "", err
+	}
+
+	version, err := a.java.Version(home)
+	if err != nil {
+		return "", err
+	}
+
+	if version != major {
+		return "", errors.Errorf("JDK in %s is version %d, expected %d", home, version, major)
+	}
+
+	return home, nil
+}
+
+// requiredVersion returns the major version from the version parameter,
+// .java-version or .sdkmanrc, in that order.
+func (a *JavaAction) requiredVersion() (int, error) {
+	if a.args.Version > 0 {
+		return a.args.Version, nil
+	}
+
+	dir, err := a.fs.CurrentDir()
+	if err != nil {
+		return 0, err
+	}
+
+	sources := []struct {
+		name  string
+		parse func(string) (int, error)
+	}{
+		{".java-version", java.ParseJavaVersionFile},
+		{".sdkmanrc", java.ParseSdkmanrc},
+	}
+
+	for _, source := range sources {
+		path := filepath.Join(dir, source.name)
+		if !a.fs.Exists(path) {
+			continue
+		}
+
+		content, err := a.fs.ReadString(path)
+		if err != nil {
+			return 0, err
+		}
+
+		major, err := source.parse(content)
+		if err != nil {
+			return 0, errors.Errorf("Invalid java version in %s: %s", source.name, err)
+		}
+
+		if major > 0 {
+			return major, nil
+		}
+	}
+
+	return 0, errors.Errorf("No java version found. Add a .java-version file or set the version parameter")
+}
+
+func javaShellSnippet(home string) string {
+	return fmt.Sprintf("export JAVA_HOME=%q\nexport PATH=\"$JAVA_HOME/bin:$PATH\"\n", home)
+}
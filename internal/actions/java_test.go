@@ -0,0 +1,98 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/cli/java/mockjava"
+	"github.com/renegumroad/gum-cli/internal/filesystem/mockfilesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/shellmanager/mockshellmanager"
+	"github.com/renegumroad/gum-cli/internal/systeminfo/mocksysteminfo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const javaTestHome = "/usr/lib/jvm/java-17-openjdk-amd64"
+
+type javaActionSuite struct {
+	suite.Suite
+	mockFs    *mockfilesystem.MockClient
+	mockSys   *mocksysteminfo.MockClient
+	mockJava  *mockjava.MockClient
+	mockShell *mockshellmanager.MockClient
+}
+
+func (s *javaActionSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *javaActionSuite) SetupTest() {
+	s.mockFs = mockfilesystem.NewMockClient(s.T())
+	s.mockSys = mocksysteminfo.NewMockClient(s.T())
+	s.mockJava = mockjava.NewMockClient(s.T())
+	s.mockShell = mockshellmanager.NewMockClient(s.T())
+	s.mockFs.EXPECT().CurrentDir().Return("/app", nil).Maybe()
+}
+
+func (s *javaActionSuite) newAction(args JavaArgs) *JavaAction {
+	return newJavaActionWithComponents(args, s.mockFs, s.mockSys, s.mockJava, s.mockShell)
+}
+
+func (s *javaActionSuite) withFiles(files map[string]string) {
+	s.mockFs.EXPECT().Exists(mock.Anything).RunAndReturn(func(path string) bool {
+		_, found := files[path]
+		return found
+	}).Maybe()
+
+	for path, content := range files {
+		s.mockFs.EXPECT().ReadString(path).Return(content, nil).Maybe()
+	}
+}
+
+func (s *javaActionSuite) TestRequiredVersionPrecedence() {
+	s.withFiles(map[string]string{"/app/.java-version": "11\n", "/app/.sdkmanrc": "java=21.0.3-tem\n"})
+
+	major, err := s.newAction(JavaArgs{}).requiredVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(11, major)
+
+	major, err = s.newAction(JavaArgs{Version: 17}).requiredVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(17, major)
+}
+
+func (s *javaActionSuite) TestValidateWithoutVersion() {
+	s.withFiles(map[string]string{})
+
+	s.Require().ErrorContains(s.newAction(JavaArgs{}).Validate(), "No java version found")
+}
+
+func (s *javaActionSuite) TestNeedsSetupUpToDate() {
+	s.withFiles(map[string]string{"/app/.sdkmanrc": "java=17.0.11-tem\n"})
+	s.mockJava.EXPECT().Home(17).Return(javaTestHome, nil)
+	s.mockJava.EXPECT().Version(javaTestHome).Return(17, nil)
+	s.mockShell.EXPECT().ReadManagedConfig("java").Return(javaShellSnippet(javaTestHome), nil)
+
+	s.Require().False(s.newAction(JavaArgs{}).needsSetup())
+}
+
+func (s *javaActionSuite) TestNeedsSetupWrongVersion() {
+	s.mockJava.EXPECT().Home(17).Return(javaTestHome, nil)
+	s.mockJava.EXPECT().Version(javaTestHome).Return(11, nil)
+
+	s.Require().True(s.newAction(JavaArgs{Version: 17}).needsSetup())
+}
+
+func (s *javaActionSuite) TestRunExportsJavaHome() {
+	s.mockJava.EXPECT().Home(17).Return(javaTestHome, nil)
+	s.mockJava.EXPECT().Version(javaTestHome).Return(17, nil)
+	s.mockShell.EXPECT().ReadManagedConfig("java").Return("", nil)
+	s.mockShell.EXPECT().WriteManagedConfig("java", "export JAVA_HOME=\"/usr/lib/jvm/java-17-openjdk-amd64\"\nexport PATH=\"$JAVA_HOME/bin:$PATH\"\n").Return(nil)
+
+	s.Require().NoError(s.newAction(JavaArgs{Version: 17}).Run())
+}
+
+func TestJavaActionSuite(t *testing.T) {
+	suite.Run(t, new(javaActionSuite))
+}
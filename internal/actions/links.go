@@ -0,0 +1,388 @@
+package actions
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/lockhash"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/repos"
+	"github.com/renegumroad/gum-cli/internal/state"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+)
+
+const linksBackupSuffix = ".gum-backup"
+
+// LinksArgs links files of the project, or of a dotfiles repository cloned
+// with gum clone when Repo is set, into the home directory.
+type LinksArgs struct {
+	Repo  string     `yaml:"repo,omitempty"`
+	Files []LinkArgs `yaml:"files"`
+}
+
+// LinkArgs links Target, in the home directory when it starts with ~/, to
+// Source, relative to the project or the repository. With Copy, the file is
+// copied instead, for tools that don't follow symlinks.
+type LinkArgs struct {
+	Source string `yaml:"source"`
+	Target string `yaml:"target"`
+	Copy   bool   `yaml:"copy,omitempty"`
+}
+
+// linkState is the state of the target of a link.
+type linkState int
+
+const (
+	linkMissing linkState = iota
+	linkUpToDate
+	// linkReplaceable targets are regular files or directories, backed up or
+	// adopted before being replaced.
+	linkReplaceable
+	// linkOutdatedCopy targets are copies written by gum and left untouched
+	// since, overwritten when the source changes.
+	linkOutdatedCopy
+	// linkConflict targets are symlinks to another file, or targets whose
+	// backup already exists. gum leaves them alone.
+	linkConflict
+)
+
+type LinksAction struct {
+	source string
+	args   *LinksArgs
+	adopt  bool
+	fs     filesystem.Client
+	sys    systeminfo.Client
+	state  state.Client
+	repos  func() (repos.Client, error)
+}
+
+// NewLinksAction creates the links of args. With adopt, existing targets are
+// moved to their source instead of being backed up, to keep local changes in
+// the dotfiles.
+func NewLinksAction(source string, args *LinksArgs, adopt bool) *LinksAction {
+	return newLinksActionWithComponents(source, args, adopt, filesystem.New(), systeminfo.New(), state.New(), repos.New)
+}
+
+func newLinksActionWithComponents(
+	source string,
+	args *LinksArgs,
+	adopt bool,
+	fs filesystem.Client,
+	sys systeminfo.Client,
+	stateClient state.Client,
+	reposClient func() (repos.Client, error),
+) *LinksAction {
+	return &LinksAction{
+		source: source,
+		args:   args,
+		adopt:  adopt,
+		fs:     fs,
+		sys:    sys,
+		state:  stateClient,
+		repos:  reposClient,
+	}
+}
+
+func (a *LinksAction) Name() string {
+	return "links"
+}
+
+func (a *LinksAction) Identifier() string {
+	targets := []string{}
+	for _, file := range a.args.Files {
+		targets = append(targets, file.Target)
+	}
+
+	return "links-" + strings.Join(targets, "-")
+}
+
+func (a *LinksAction) IsPublic() bool {
+	return true
+}
+
+func (a *LinksAction) Platforms() []systeminfo.Platform {
+	return []systeminfo.Platform{systeminfo.Darwin, systeminfo.Linux}
+}
+
+// Deps clones the dotfiles repository.
+func (a *LinksAction) Deps() []Action {
+	if a.args.Repo == "" {
+		return []Action{}
+	}
+
+	return []Action{NewReposAction(a.source, []RepoArgs{{Name: a.args.Repo}})}
+}
+
+func (a *LinksAction) Validate() error {
+	if len(a.args.Files) == 0 {
+		return errors.Errorf("%s: links require at least one file", a.source)
+	}
+
+	if a.args.Repo != "" {
+		if _, err := repos.Parse(a.args.Repo); err != nil {
+			return errors.Errorf("%s: %s", a.source, err)
+		}
+	}
+
+	for _, file := range a.args.Files {
+		if file.Source == "" || file.Target == "" {
+			return errors.Errorf("%s: links require a source and a target", a.source)
+		}
+
+		if filepath.IsAbs(file.Source) || strings.HasPrefix(filepath.Clean(file.Source), "..") {
+			return errors.Errorf("%s: link source %s must be relative to the project or the repository", a.source, file.Source)
+		}
+
+		if !strings.HasPrefix(file.Target, "~/") && !filepath.IsAbs(file.Target) {
+			return errors.Errorf("%s: link target %s must be absolute or start with ~/", a.source, file.Target)
+		}
+	}
+
+	return nil
+}
+
+func (a *LinksAction) ShouldRun() bool {
+	return depsShouldRun(a.Deps()) || a.needsLinks()
+}
+
+func (a *LinksAction) needsLinks() bool {
+	for _, file := range a.args.Files {
+		source, target, err := a.paths(file)
+		if err != nil {
+			log.Debugf("%s", err)
+			return true
+		}
+
+		if state, _ := a.targetState(file, source, target); state != linkUpToDate {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *LinksAction) Run() error {
+	conflicts := []string{}
+
+	for _, file := range a.args.Files {
+		source, target, err := a.paths(file)
+		if err != nil {
+			return err
+		}
+
+		if !a.fs.Exists(source) {
+			return errors.Errorf("%s: link source %s does not exist", a.source, source)
+		}
+
+		if file.Copy && a.fs.IsDir(source) {
+			return errors.Errorf("%s: %s is a directory, which can only be linked", a.source, source)
+		}
+
+		state, reason := a.targetState(file, source, target)
+		switch state {
+		case linkUpToDate:
+			log.Debugf("%s is up to date", target)
+			if file.Copy {
+				a.recordCopy(target)
+			}
+			continue
+		case linkOutdatedCopy:
+			log.Debugf("%s is an outdated copy of %s", target, source)
+		case linkConflict:
+			conflicts = append(conflicts, reason)
+			continue
+		case linkReplaceable:
+			if err := a.replace(source, target); err != nil {
+				return err
+			}
+		case linkMissing:
+			if err := a.ensureDir(filepath.Dir(target)); err != nil {
+				return err
+			}
+		}
+
+		if err := a.create(file, source, target); err != nil {
+			return err
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return errors.Errorf("%s: gum left these files alone, move them away and run gum dev up again:\n%s", a.source, strings.Join(conflicts, "\n"))
+	}
+
+	return nil
+}
+
+// targetState returns the state of the target of a link and, for conflicts, why.
+func (a *LinksAction) targetState(file LinkArgs, source, target string) (linkState, string) {
+	if a.fs.IsSymlink(target) {
+		link, err := a.fs.Readlink(target)
+		if err != nil {
+			return linkConflict, err.Error()
+		}
+
+		if !file.Copy && link == source {
+			return linkUpToDate, ""
+		}
+
+		return linkConflict, target + " links to " + link
+	}
+
+	if !a.fs.Exists(target) {
+		return linkMissing, ""
+	}
+
+	if file.Copy && a.fs.IsFile(target) {
+		if equal, err := a.fs.EqualFiles(source, target); err == nil && equal {
+			return linkUpToDate, ""
+		}
+
+		if a.isOwnCopy(target) {
+			return linkOutdatedCopy, ""
+		}
+	}
+
+	if !a.adopt && a.fs.Exists(target+linksBackupSuffix) {
+		return linkConflict, target + " differs from " + source + " and its backup " + target + linksBackupSuffix + " exists"
+	}
+
+	return linkReplaceable, ""
+}
+
+// replace moves an existing target out of the way: to its source in adopt
+// mode, otherwise to a backup next to it.
+func (a *LinksAction) replace(source, target string) error {
+	if a.adopt {
+		log.Infof("Adopting %s into %s", target, source)
+		if a.fs.IsDir(source) != a.fs.IsDir(target) {
+			return errors.Errorf("%s: cannot adopt %s, it is not the same kind of file as %s", a.source, target, source)
+		}
+
+		if a.fs.IsDir(source) {
+			return errors.Errorf("%s: cannot adopt the %s directory, merge it into %s by hand", a.source, target, source)
+		}
+
+		return a.fs.Rename(target, source)
+	}
+
+	backup := target + linksBackupSuffix
+	log.Warnf("Backing up %s to %s", target, backup)
+
+	return a.fs.Rename(target, backup)
+}
+
+func (a *LinksAction) create(file LinkArgs, source, target string) error {
+	if !file.Copy {
+		log.Infof("Linking %s to %s", target, source)
+		return a.fs.Symlink(source, target)
+	}
+
+	log.Infof("Copying %s to %s", source, target)
+	if err := a.fs.CopyFile(source, target); err != nil {
+		return errors.Errorf("Failed to copy %s to %s: %s", source, target, err)
+	}
+
+	a.recordCopy(target)
+
+	return a.ensureOwnership(target)
+}
+
+// recordCopy stores the hash of a copy in the state store, so that a later
+// change of the source replaces it instead of backing it up as a user file.
+func (a *LinksAction) recordCopy(target string) {
+	hash, err := lockhash.Hash(a.fs, target)
+	if err != nil {
+		log.Debugf("%s", err)
+		return
+	}
+
+	if recorded, found, err := a.state.Get(copyKey(target)); err == nil && found && recorded == hash {
+		return
+	}
+
+	if err := a.state.Set(copyKey(target), hash); err != nil {
+		log.Warnf("Unable to record the copy of %s: %s", target, err)
+	}
+}
+
+// isOwnCopy reports whether target is still the copy gum wrote.
+func (a *LinksAction) isOwnCopy(target string) bool {
+	recorded, found, err := a.state.Get(copyKey(target))
+	if err != nil || !found {
+		return false
+	}
+
+	hash, err := lockhash.Hash(a.fs, target)
+
+	return err == nil && hash == recorded
+}
+
+func copyKey(target string) string {
+	return "links:" + target + ":copy"
+}
+
+// ensureDir creates dir and, under sudo, gives the directories it created to
+// the user running sudo.
+func (a *LinksAction) ensureDir(dir string) error {
+	created := dir
+	for parent := filepath.Dir(created); !a.fs.Exists(parent) && parent != created; parent = filepath.Dir(parent) {
+		created = parent
+	}
+
+	if a.fs.Exists(created) {
+		return nil
+	}
+
+	if err := a.fs.MkdirAll(dir); err != nil {
+		return err
+	}
+
+	return a.ensureOwnership(created)
+}
+
+func (a *LinksAction) ensureOwnership(path string) error {
+	if !a.sys.IsSudo() {
+		return nil
+	}
+
+	return a.fs.EnsureNonSudoOwnership(path)
+}
+
+// paths returns the absolute source and target of a link.
+func (a *LinksAction) paths(file LinkArgs) (string, string, error) {
+	base, err := a.baseDir()
+	if err != nil {
+		return "", "", err
+	}
+
+	target := file.Target
+	if rest, found := strings.CutPrefix(target, "~/"); found {
+		homeDir, err := a.fs.HomeDir()
+		if err != nil {
+			return "", "", err
+		}
+		target = filepath.Join(homeDir, rest)
+	}
+
+	return filepath.Join(base, file.Source), filepath.Clean(target), nil
+}
+
+func (a *LinksAction) baseDir() (string, error) {
+	if a.args.Repo == "" {
+		return a.fs.CurrentDir()
+	}
+
+	repo, err := repos.Parse(a.args.Repo)
+	if err != nil {
+		return "", err
+	}
+
+	client, err := a.repos()
+	if err != nil {
+		return "", err
+	}
+
+	return client.Path(repo), nil
+}
@@ -0,0 +1,160 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/repos"
+	"github.com/renegumroad/gum-cli/internal/repos/mockrepos"
+	"github.com/renegumroad/gum-cli/internal/state/mockstate"
+	"github.com/renegumroad/gum-cli/internal/systeminfo/mocksysteminfo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type linksActionSuite struct {
+	suite.Suite
+	dir       string
+	homeDir   string
+	mockSys   *mocksysteminfo.MockClient
+	mockState *mockstate.MockClient
+	mockRepos *mockrepos.MockClient
+	state     map[string]string
+}
+
+func (s *linksActionSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *linksActionSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+	s.homeDir = s.T().TempDir()
+	s.mockSys = mocksysteminfo.NewMockClient(s.T())
+	s.mockSys.EXPECT().IsSudo().Return(false).Maybe()
+	s.mockRepos = mockrepos.NewMockClient(s.T())
+	s.state = map[string]string{}
+	s.mockState = mockstate.NewMockClient(s.T())
+	s.mockState.EXPECT().Get(mock.Anything).RunAndReturn(func(key string) (string, bool, error) {
+		value, found := s.state[key]
+		return value, found, nil
+	}).Maybe()
+	s.mockState.EXPECT().Set(mock.Anything, mock.Anything).RunAndReturn(func(key, value string) error {
+		s.state[key] = value
+		return nil
+	}).Maybe()
+}
+
+func (s *linksActionSuite) newAction(args *LinksArgs, adopt bool) *LinksAction {
+	reposClient := func() (repos.Client, error) {
+		return s.mockRepos, nil
+	}
+	fs := &workDirFileSystem{Client: filesystem.New(), dir: s.dir, homeDir: s.homeDir}
+
+	return newLinksActionWithComponents("gum.yml up[0]", args, adopt, fs, s.mockSys, s.mockState, reposClient)
+}
+
+func (s *linksActionSuite) writeFile(path, content string) {
+	s.Require().NoError(os.MkdirAll(filepath.Dir(path), 0755))
+	s.Require().NoError(os.WriteFile(path, []byte(content), 0644))
+}
+
+func (s *linksActionSuite) readFile(path string) string {
+	content, err := os.ReadFile(path)
+	s.Require().NoError(err)
+
+	return string(content)
+}
+
+func (s *linksActionSuite) TestValidate() {
+	s.Require().EqualError(s.newAction(&LinksArgs{}, false).Validate(), "gum.yml up[0]: links require at least one file")
+	s.Require().EqualError(s.newAction(&LinksArgs{Files: []LinkArgs{{Source: "../irbrc", Target: "~/.irbrc"}}}, false).Validate(), "gum.yml up[0]: link source ../irbrc must be relative to the project or the repository")
+	s.Require().EqualError(s.newAction(&LinksArgs{Files: []LinkArgs{{Source: "irbrc", Target: ".irbrc"}}}, false).Validate(), "gum.yml up[0]: link target .irbrc must be absolute or start with ~/")
+	s.Require().NoError(s.newAction(&LinksArgs{Repo: "gumroad/dotfiles", Files: []LinkArgs{{Source: "irbrc", Target: "~/.irbrc"}}}, false).Validate())
+}
+
+func (s *linksActionSuite) TestRunLinksAndCopies() {
+	s.writeFile(filepath.Join(s.dir, "dotfiles", "irbrc"), "IRB.conf[:SAVE_HISTORY] = 1000\n")
+	s.writeFile(filepath.Join(s.dir, "dotfiles", "nvim", "init.lua"), "vim.o.number = true\n")
+	s.writeFile(filepath.Join(s.dir, "dotfiles", "psqlrc"), "\\timing\n")
+	action := s.newAction(&LinksArgs{Files: []LinkArgs{
+		{Source: "dotfiles/irbrc", Target: "~/.irbrc"},
+		{Source: "dotfiles/nvim", Target: "~/.config/nvim"},
+		{Source: "dotfiles/psqlrc", Target: "~/.psqlrc", Copy: true},
+	}}, false)
+
+	s.Require().True(action.ShouldRun())
+	s.Require().NoError(action.Run())
+	s.Require().False(action.ShouldRun())
+
+	link, err := os.Readlink(filepath.Join(s.homeDir, ".config", "nvim"))
+	s.Require().NoError(err)
+	s.Require().Equal(filepath.Join(s.dir, "dotfiles", "nvim"), link)
+	s.Require().Equal("IRB.conf[:SAVE_HISTORY] = 1000\n", s.readFile(filepath.Join(s.homeDir, ".irbrc")))
+	s.Require().False(filesystem.New().IsSymlink(filepath.Join(s.homeDir, ".psqlrc")))
+
+	s.writeFile(filepath.Join(s.dir, "dotfiles", "psqlrc"), "\\timing\n\\x auto\n")
+	s.Require().True(action.ShouldRun())
+}
+
+func (s *linksActionSuite) TestRunUpdatesCopies() {
+	source := filepath.Join(s.dir, "psqlrc")
+	target := filepath.Join(s.homeDir, ".psqlrc")
+	s.writeFile(source, "\\timing\n")
+	action := s.newAction(&LinksArgs{Files: []LinkArgs{{Source: "psqlrc", Target: "~/.psqlrc", Copy: true}}}, false)
+	s.Require().NoError(action.Run())
+
+	for _, content := range []string{"\\timing\n\\x auto\n", "\\timing\n\\x auto\n\\pset null NULL\n"} {
+		s.writeFile(source, content)
+
+		s.Require().True(action.ShouldRun())
+		s.Require().NoError(action.Run())
+		s.Require().False(action.ShouldRun())
+		s.Require().Equal(content, s.readFile(target))
+		s.Require().NoFileExists(target + ".gum-backup")
+	}
+
+	s.writeFile(target, "local\n")
+	s.writeFile(source, "\\timing\n")
+
+	s.Require().NoError(action.Run())
+	s.Require().Equal("local\n", s.readFile(target+".gum-backup"))
+	s.Require().Equal("\\timing\n", s.readFile(target))
+}
+
+func (s *linksActionSuite) TestRunBacksUpAndReportsConflicts() {
+	s.writeFile(filepath.Join(s.dir, "irbrc"), "project\n")
+	s.writeFile(filepath.Join(s.dir, "psqlrc"), "project\n")
+	s.writeFile(filepath.Join(s.homeDir, ".irbrc"), "local\n")
+	s.Require().NoError(os.Symlink("/etc/psqlrc", filepath.Join(s.homeDir, ".psqlrc")))
+	action := s.newAction(&LinksArgs{Files: []LinkArgs{
+		{Source: "irbrc", Target: "~/.irbrc"},
+		{Source: "psqlrc", Target: "~/.psqlrc"},
+	}}, false)
+
+	err := action.Run()
+
+	s.Require().EqualError(err, "gum.yml up[0]: gum left these files alone, move them away and run gum dev up again:\n"+filepath.Join(s.homeDir, ".psqlrc")+" links to /etc/psqlrc")
+	s.Require().Equal("local\n", s.readFile(filepath.Join(s.homeDir, ".irbrc.gum-backup")))
+	s.Require().Equal("project\n", s.readFile(filepath.Join(s.homeDir, ".irbrc")))
+}
+
+func (s *linksActionSuite) TestRunAdopts() {
+	s.writeFile(filepath.Join(s.homeDir, "src", "dotfiles", "irbrc"), "team\n")
+	s.writeFile(filepath.Join(s.homeDir, ".irbrc"), "local\n")
+	s.mockRepos.EXPECT().Path(&repos.Repo{Host: "github.com", Org: "gumroad", Name: "dotfiles"}).Return(filepath.Join(s.homeDir, "src", "dotfiles"))
+
+	err := s.newAction(&LinksArgs{Repo: "gumroad/dotfiles", Files: []LinkArgs{{Source: "irbrc", Target: "~/.irbrc"}}}, true).Run()
+
+	s.Require().NoError(err)
+	s.Require().Equal("local\n", s.readFile(filepath.Join(s.homeDir, "src", "dotfiles", "irbrc")))
+	s.Require().True(filesystem.New().IsSymlink(filepath.Join(s.homeDir, ".irbrc")))
+	s.Require().NoFileExists(filepath.Join(s.homeDir, ".irbrc.gum-backup"))
+}
+
+func TestLinksActionSuite(t *testing.T) {
+	suite.Run(t, new(linksActionSuite))
+}
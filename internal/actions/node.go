@@ -0,0 +1,158 @@
+package actions
+
+import (
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/homebrew"
+	"github.com/renegumroad/gum-cli/internal/cli/nodepm"
+	"github.com/renegumroad/gum-cli/internal/cli/versionmanager"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+)
+
+type NodeAction struct {
+	fs         filesystem.Client
+	manager    versionmanager.Client
+	managerErr error
+	pm         nodepm.Client
+}
+
+func NewNodeAction() *NodeAction {
+	manager, err := versionmanager.New()
+
+	return newNodeActionWithComponents(filesystem.New(), manager, err, nodepm.New())
+}
+
+func newNodeActionWithComponents(
+	fs filesystem.Client,
+	manager versionmanager.Client,
+	managerErr error,
+	pm nodepm.Client,
+) *NodeAction {
+	return &NodeAction{
+		fs:         fs,
+		manager:    manager,
+		managerErr: managerErr,
+		pm:         pm,
+	}
+}
+
+func (a *NodeAction) Name() string {
+	return "node"
+}
+
+func (a *NodeAction) Identifier() string {
+	return "node"
+}
+
+func (a *NodeAction) IsPublic() bool {
+	return true
+}
+
+func (a *NodeAction) Platforms() []systeminfo.Platform {
+	return []systeminfo.Platform{systeminfo.Darwin, systeminfo.Linux}
+}
+
+func (a *NodeAction) Deps() []Action {
+	if a.manager == nil {
+		return []Action{}
+	}
+
+	return []Action{
+		NewBrewAction(
+			"action node",
+			[]homebrew.Package{
+				a.manager.Package(),
+			}),
+	}
+}
+
+func (a *NodeAction) Validate() error {
+	if a.managerErr != nil {
+		return errors.Errorf("Failed %s action validation: %s", a.Name(), a.managerErr)
+	}
+
+	if !a.manager.Supports(versionmanager.Node) {
+		return errors.Errorf("Failed %s action validation: %s cannot install node. Set version_manager to mise or asdf in ~/.gum/config.yml", a.Name(), a.manager.Name())
+	}
+
+	return nil
+}
+
+func (a *NodeAction) ShouldRun() bool {
+	return depsShouldRun(a.Deps()) || a.needsSetup()
+}
+
+// needsSetup checks the node version and whether the dependencies were
+// installed from the current lockfile.
+func (a *NodeAction) needsSetup() bool {
+	version, err := a.requiredVersion()
+	if err != nil {
+		log.Debugf("Unable to read the node version: %s", err)
+		return true
+	}
+
+	if version != nil && !a.manager.IsInstalled(versionmanager.Node, version.Version) {
+		log.Debugf("Node %s is not installed", version.Version)
+		return true
+	}
+
+	return !a.pm.IsInstallCurrent()
+}
+
+func (a *NodeAction) Run() error {
+	if err := a.ensureNodeInstalled(); err != nil {
+		return err
+	}
+
+	if !a.pm.HasPackageJSON() {
+		log.Infof("No package.json found, skipping dependencies installation")
+		return nil
+	}
+
+	manager, err := a.pm.PackageManager()
+	if err != nil {
+		return err
+	}
+
+	if manager != nodepm.Npm {
+		if err := a.pm.EnableCorepack(); err != nil {
+			return err
+		}
+	}
+
+	if a.pm.IsInstallCurrent() {
+		log.Infof("Node dependencies are already installed")
+		return nil
+	}
+
+	return a.pm.Install()
+}
+
+func (a *NodeAction) ensureNodeInstalled() error {
+	version, err := a.requiredVersion()
+	if err != nil {
+		return err
+	}
+
+	if version == nil {
+		log.Infof("No node version declared, using the %s default", a.manager.Name())
+		return nil
+	}
+
+	if a.manager.IsInstalled(versionmanager.Node, version.Version) {
+		log.Infof("Node %s is already installed", version.Version)
+		return nil
+	}
+
+	return a.manager.Install(versionmanager.Node, version.Version)
+}
+
+func (a *NodeAction) requiredVersion() (*versionmanager.Version, error) {
+	dir, err := a.fs.CurrentDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return versionmanager.RequiredVersion(a.fs, dir, versionmanager.Node)
+}
@@ -0,0 +1,96 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/cli/nodepm/mocknodepm"
+	"github.com/renegumroad/gum-cli/internal/cli/versionmanager/mockversionmanager"
+	"github.com/renegumroad/gum-cli/internal/filesystem/mockfilesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type nodeActionSuite struct {
+	suite.Suite
+	mockFs      *mockfilesystem.MockClient
+	mockManager *mockversionmanager.MockClient
+	mockPm      *mocknodepm.MockClient
+	act         *NodeAction
+}
+
+func (s *nodeActionSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *nodeActionSuite) SetupTest() {
+	s.mockFs = mockfilesystem.NewMockClient(s.T())
+	s.mockManager = mockversionmanager.NewMockClient(s.T())
+	s.mockPm = mocknodepm.NewMockClient(s.T())
+	s.act = newNodeActionWithComponents(s.mockFs, s.mockManager, nil, s.mockPm)
+}
+
+func (s *nodeActionSuite) withNodeVersion(version string) {
+	s.mockFs.EXPECT().CurrentDir().Return("/app", nil)
+	s.mockFs.EXPECT().Exists("/app/.node-version").Return(true)
+	s.mockFs.EXPECT().ReadString("/app/.node-version").Return(version, nil)
+}
+
+func (s *nodeActionSuite) TestValidateUnsupportedManager() {
+	s.mockManager.EXPECT().Supports("node").Return(false)
+	s.mockManager.EXPECT().Name().Return("rbenv")
+
+	s.Require().ErrorContains(s.act.Validate(), "rbenv cannot install node")
+}
+
+func (s *nodeActionSuite) TestNeedsSetupNodeMissing() {
+	s.withNodeVersion("20.15.0")
+	s.mockManager.EXPECT().IsInstalled("node", "20.15.0").Return(false)
+
+	s.Require().True(s.act.needsSetup())
+}
+
+func (s *nodeActionSuite) TestNeedsSetupInstallCurrent() {
+	s.withNodeVersion("20.15.0")
+	s.mockManager.EXPECT().IsInstalled("node", "20.15.0").Return(true)
+	s.mockPm.EXPECT().IsInstallCurrent().Return(true)
+
+	s.Require().False(s.act.needsSetup())
+}
+
+func (s *nodeActionSuite) TestRunInstallsNodeAndDependencies() {
+	s.withNodeVersion("20.15.0")
+	s.mockManager.EXPECT().IsInstalled("node", "20.15.0").Return(false)
+	s.mockManager.EXPECT().Install("node", "20.15.0").Return(nil)
+	s.mockPm.EXPECT().HasPackageJSON().Return(true)
+	s.mockPm.EXPECT().PackageManager().Return("npm", nil)
+	s.mockPm.EXPECT().IsInstallCurrent().Return(false)
+	s.mockPm.EXPECT().Install().Return(nil)
+
+	s.Require().NoError(s.act.Run())
+	s.mockPm.AssertNotCalled(s.T(), "EnableCorepack")
+}
+
+func (s *nodeActionSuite) TestRunEnablesCorepackForPnpm() {
+	s.withNodeVersion("20.15.0")
+	s.mockManager.EXPECT().IsInstalled("node", "20.15.0").Return(true)
+	s.mockPm.EXPECT().HasPackageJSON().Return(true)
+	s.mockPm.EXPECT().PackageManager().Return("pnpm", nil)
+	s.mockPm.EXPECT().EnableCorepack().Return(nil)
+	s.mockPm.EXPECT().IsInstallCurrent().Return(true)
+
+	s.Require().NoError(s.act.Run())
+	s.mockPm.AssertNotCalled(s.T(), "Install")
+}
+
+func (s *nodeActionSuite) TestRunWithoutPackageJSON() {
+	s.withNodeVersion("20.15.0")
+	s.mockManager.EXPECT().IsInstalled("node", "20.15.0").Return(true)
+	s.mockPm.EXPECT().HasPackageJSON().Return(false)
+
+	s.Require().NoError(s.act.Run())
+}
+
+func TestNodeActionSuite(t *testing.T) {
+	suite.Run(t, new(nodeActionSuite))
+}
@@ -0,0 +1,180 @@
+package actions
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/yaml"
+)
+
+type ParamType string
+
+const (
+	StringParam     ParamType = "string"
+	BoolParam       ParamType = "bool"
+	IntParam        ParamType = "integer"
+	StringListParam ParamType = "list of strings"
+	DurationParam   ParamType = "duration"
+)
+
+// Param describes a with: parameter of a named action. Values restricts a
+// string parameter to a set of values.
+type Param struct {
+	Name        string
+	Type        ParamType
+	Description string
+	Values      []string
+}
+
+// Schema lists the with: parameters accepted by a named action. Its params
+// match the yaml fields of the options struct of the action.
+type Schema []Param
+
+func (s Schema) param(name string) (Param, bool) {
+	for _, param := range s {
+		if param.Name == name {
+			return param, true
+		}
+	}
+
+	return Param{}, false
+}
+
+func (s Schema) names() []string {
+	names := []string{}
+	for _, param := range s {
+		names = append(names, param.Name)
+	}
+
+	return names
+}
+
+// Validate checks the with: parameters given to action against the schema,
+// reporting every unknown or wrongly typed key.
+func (s Schema) Validate(action string, with map[string]interface{}) error {
+	if len(with) == 0 {
+		return nil
+	}
+
+	if len(s) == 0 {
+		return errors.Errorf("Named action %s does not accept parameters", action)
+	}
+
+	keys := []string{}
+	for key := range with {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	problems := []string{}
+	for _, key := range keys {
+		param, found := s.param(key)
+		if !found {
+			problems = append(problems, fmt.Sprintf("unknown parameter %q. Supported: %s", key, strings.Join(s.names(), ", ")))
+			continue
+		}
+
+		if problem := param.check(with[key]); problem != "" {
+			problems = append(problems, problem)
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.Errorf("Invalid parameters for named action %s: %s", action, strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+// check returns a description of what is wrong with value, or an empty
+// string when it is valid.
+func (p Param) check(value interface{}) string {
+	valid := false
+
+	switch p.Type {
+	case StringParam:
+		str, ok := value.(string)
+		valid = ok
+		if ok && len(p.Values) > 0 && !slices.Contains(p.Values, str) {
+			return fmt.Sprintf("parameter %q must be one of %s, got %q", p.Name, strings.Join(p.Values, ", "), str)
+		}
+	case BoolParam:
+		_, valid = value.(bool)
+	case IntParam:
+		_, valid = value.(int)
+	case DurationParam:
+		str, ok := value.(string)
+		if _, err := time.ParseDuration(str); ok && err != nil {
+			return fmt.Sprintf("parameter %q must be a duration such as 30s or 10m, got %q", p.Name, str)
+		}
+		valid = ok
+	case StringListParam:
+		list, ok := value.([]interface{})
+		valid = ok
+		for _, item := range list {
+			if _, ok := item.(string); !ok {
+				valid = false
+			}
+		}
+	}
+
+	if !valid {
+		return fmt.Sprintf("parameter %q must be a %s, got %s", p.Name, p.Type, describeValue(value))
+	}
+
+	return ""
+}
+
+func describeValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "nothing"
+	case string:
+		return fmt.Sprintf("string %q", v)
+	case bool:
+		return fmt.Sprintf("bool %t", v)
+	case int:
+		return fmt.Sprintf("integer %d", v)
+	case []interface{}:
+		return "a list"
+	case map[string]interface{}:
+		return "a map"
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// Describe renders the schema, one parameter per line.
+func (s Schema) Describe() string {
+	if len(s) == 0 {
+		return "  no parameters\n"
+	}
+
+	var b strings.Builder
+	for _, param := range s {
+		fmt.Fprintf(&b, "  %s (%s): %s", param.Name, param.Type, param.Description)
+		if len(param.Values) > 0 {
+			fmt.Fprintf(&b, " One of %s.", strings.Join(param.Values, ", "))
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// decodeParams decodes with: parameters, already validated against the
+// schema, into the options struct of the action.
+func decodeParams(action string, with map[string]interface{}, out interface{}) error {
+	if len(with) == 0 {
+		return nil
+	}
+
+	if err := yaml.Decode(with, out); err != nil {
+		return errors.Errorf("Invalid parameters for named action %s: %s", action, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type paramsSuite struct {
+	suite.Suite
+}
+
+func (s *paramsSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *paramsSuite) TestValidateUnknownParameter() {
+	err := ValidateParams("golang", map[string]interface{}{"tool": []interface{}{"gopls"}})
+
+	s.Require().EqualError(err, `Invalid parameters for named action golang: unknown parameter "tool". Supported: tools, toolchain, mod_download, project_tools`)
+}
+
+func (s *paramsSuite) TestValidateWrongTypes() {
+	err := ValidateParams("golang", map[string]interface{}{
+		"mod_download": "yes",
+		"tools":        []interface{}{"gopls", 3},
+		"toolchain":    "always",
+	})
+
+	s.Require().EqualError(err, `Invalid parameters for named action golang: `+
+		`parameter "mod_download" must be a bool, got string "yes"; `+
+		`parameter "toolchain" must be one of ignore, warn, install, got "always"; `+
+		`parameter "tools" must be a list of strings, got a list`)
+}
+
+func (s *paramsSuite) TestValidateDuration() {
+	s.Require().NoError(ValidateParams("xcode", map[string]interface{}{"timeout": "45m"}))
+
+	err := ValidateParams("xcode", map[string]interface{}{"timeout": "soon"})
+	s.Require().EqualError(err, `Invalid parameters for named action xcode: parameter "timeout" must be a duration such as 30s or 10m, got "soon"`)
+
+	err = ValidateParams("xcode", map[string]interface{}{"timeout": 10})
+	s.Require().EqualError(err, `Invalid parameters for named action xcode: parameter "timeout" must be a duration, got integer 10`)
+}
+
+func (s *paramsSuite) TestValidateActionWithoutParameters() {
+	s.Require().NoError(ValidateParams("ruby", nil))
+	s.Require().ErrorContains(ValidateParams("ruby", map[string]interface{}{"version": "3.3.4"}), "Named action ruby does not accept parameters")
+	s.Require().ErrorContains(ValidateParams("perl", nil), "Named action perl does not exist")
+}
+
+func (s *paramsSuite) TestNewNamedActionDecodesParameters() {
+	act, err := NewNamedAction("golang", map[string]interface{}{
+		"tools":         []interface{}{"gopls"},
+		"toolchain":     "install",
+		"mod_download":  false,
+		"project_tools": true,
+	})
+	s.Require().NoError(err)
+
+	args := act.(*GolangAction).args
+	s.Require().Equal([]string{"gopls"}, args.Tools)
+	s.Require().Equal(ToolchainInstall, args.Toolchain)
+	s.Require().False(*args.ModDownload)
+	s.Require().True(args.ProjectTools)
+}
+
+// Every schema param must decode into the options struct of its action.
+func (s *paramsSuite) TestSchemasMatchOptions() {
+	samples := map[ParamType]interface{}{
+		StringParam:     "",
+		BoolParam:       true,
+		IntParam:        1,
+		StringListParam: []interface{}{"a"},
+		DurationParam:   "1m",
+	}
+
+	for name, named := range namedActions {
+		for _, param := range named.schema {
+			value := samples[param.Type]
+			if len(param.Values) > 0 {
+				value = param.Values[0]
+			}
+
+			_, err := named.create(map[string]interface{}{param.Name: value})
+			s.Require().NoError(err, "action %s param %s", name, param.Name)
+		}
+	}
+}
+
+func (s *paramsSuite) TestPublicNames() {
+	names := PublicNames()
+
+	s.Require().Contains(names, "golang")
+	s.Require().NotContains(names, "brew_ensure")
+}
+
+func (s *paramsSuite) TestDescribe() {
+	description, err := Describe("golang")
+
+	s.Require().NoError(err)
+	s.Require().Contains(description, "  toolchain (string): What to do when go is older")
+	s.Require().Contains(description, "One of ignore, warn, install.")
+}
+
+func TestParamsSuite(t *testing.T) {
+	suite.Run(t, new(paramsSuite))
+}
@@ -0,0 +1,155 @@
+package actions
+
+import (
+	"strings"
+
+	"github.com/renegumroad/gum-cli/internal/cli/homebrew"
+	"github.com/renegumroad/gum-cli/internal/cli/python"
+	"github.com/renegumroad/gum-cli/internal/cli/versionmanager"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+)
+
+type PythonAction struct {
+	fs     filesystem.Client
+	python python.Client
+}
+
+func NewPythonAction() *PythonAction {
+	return newPythonActionWithComponents(filesystem.New(), python.New())
+}
+
+func newPythonActionWithComponents(fs filesystem.Client, py python.Client) *PythonAction {
+	return &PythonAction{
+		fs:     fs,
+		python: py,
+	}
+}
+
+func (a *PythonAction) Name() string {
+	return "python"
+}
+
+func (a *PythonAction) Identifier() string {
+	return "python"
+}
+
+func (a *PythonAction) IsPublic() bool {
+	return true
+}
+
+func (a *PythonAction) Platforms() []systeminfo.Platform {
+	return []systeminfo.Platform{systeminfo.Darwin, systeminfo.Linux}
+}
+
+func (a *PythonAction) Deps() []Action {
+	packages := []homebrew.Package{
+		a.python.Package(),
+	}
+
+	if file, err := a.python.DependencyFile(); err == nil && file == python.PoetryLock {
+		packages = append(packages, homebrew.Package{Name: "poetry"})
+	}
+
+	return []Action{
+		NewBrewAction("action python", packages),
+	}
+}
+
+func (a *PythonAction) Validate() error {
+	return nil
+}
+
+func (a *PythonAction) ShouldRun() bool {
+	return depsShouldRun(a.Deps()) || a.needsSetup()
+}
+
+// needsSetup checks the python version, the virtualenv and whether the
+// dependencies were installed from the current lockfile.
+func (a *PythonAction) needsSetup() bool {
+	version, err := a.requiredVersion()
+	if err != nil {
+		log.Debugf("Unable to read the python version: %s", err)
+		return true
+	}
+
+	if version != "" && !a.python.IsPythonInstalled(version) {
+		log.Debugf("Python %s is not installed", version)
+		return true
+	}
+
+	if !a.isVirtualenvCurrent(version) {
+		return true
+	}
+
+	return !a.python.IsInstallCurrent()
+}
+
+func (a *PythonAction) Run() error {
+	version, err := a.requiredVersion()
+	if err != nil {
+		return err
+	}
+
+	if version == "" {
+		log.Infof("No python version declared, using the %s default", a.python.Manager())
+	} else if a.python.IsPythonInstalled(version) {
+		log.Infof("Python %s is already installed", version)
+	} else if err := a.python.InstallPython(version); err != nil {
+		return err
+	}
+
+	if a.isVirtualenvCurrent(version) {
+		log.Infof("Reusing the existing virtualenv")
+	} else if err := a.python.CreateVirtualenv(version); err != nil {
+		return err
+	}
+
+	if a.python.IsInstallCurrent() {
+		log.Infof("Python dependencies are already installed")
+		return nil
+	}
+
+	return a.python.InstallDependencies()
+}
+
+// isVirtualenvCurrent reports whether the virtualenv exists and, when a
+// version is required, was created with that version.
+func (a *PythonAction) isVirtualenvCurrent(version string) bool {
+	if !a.python.HasVirtualenv() {
+		log.Debugf("No virtualenv found")
+		return false
+	}
+
+	if version == "" {
+		return true
+	}
+
+	venvVersion, err := a.python.VirtualenvVersion()
+	if err != nil {
+		log.Debugf("%s", err)
+		return false
+	}
+
+	if venvVersion != version && !strings.HasPrefix(venvVersion, version+".") {
+		log.Debugf("Virtualenv uses python %s instead of %s", venvVersion, version)
+		return false
+	}
+
+	return true
+}
+
+func (a *PythonAction) requiredVersion() (string, error) {
+	dir, err := a.fs.CurrentDir()
+	if err != nil {
+		return "", err
+	}
+
+	version, err := versionmanager.RequiredVersion(a.fs, dir, versionmanager.Python)
+	if err != nil || version == nil {
+		return "", err
+	}
+
+	return version.Version, nil
+}
@@ -0,0 +1,92 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/cli/homebrew"
+	"github.com/renegumroad/gum-cli/internal/cli/python/mockpython"
+	"github.com/renegumroad/gum-cli/internal/filesystem/mockfilesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type pythonActionSuite struct {
+	suite.Suite
+	mockFs     *mockfilesystem.MockClient
+	mockPython *mockpython.MockClient
+	act        *PythonAction
+}
+
+func (s *pythonActionSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *pythonActionSuite) SetupTest() {
+	s.mockFs = mockfilesystem.NewMockClient(s.T())
+	s.mockPython = mockpython.NewMockClient(s.T())
+	s.act = newPythonActionWithComponents(s.mockFs, s.mockPython)
+}
+
+func (s *pythonActionSuite) withPythonVersion(version string) {
+	s.mockFs.EXPECT().CurrentDir().Return("/app", nil)
+	s.mockFs.EXPECT().Exists("/app/.python-version").Return(true)
+	s.mockFs.EXPECT().ReadString("/app/.python-version").Return(version, nil)
+}
+
+func (s *pythonActionSuite) TestNeedsSetupUpToDate() {
+	s.withPythonVersion("3.12\n")
+	s.mockPython.EXPECT().IsPythonInstalled("3.12").Return(true)
+	s.mockPython.EXPECT().HasVirtualenv().Return(true)
+	s.mockPython.EXPECT().VirtualenvVersion().Return("3.12.4", nil)
+	s.mockPython.EXPECT().IsInstallCurrent().Return(true)
+
+	s.Require().False(s.act.needsSetup())
+}
+
+func (s *pythonActionSuite) TestNeedsSetupVirtualenvVersionMismatch() {
+	s.withPythonVersion("3.12.4")
+	s.mockPython.EXPECT().IsPythonInstalled("3.12.4").Return(true)
+	s.mockPython.EXPECT().HasVirtualenv().Return(true)
+	s.mockPython.EXPECT().VirtualenvVersion().Return("3.11.9", nil)
+
+	s.Require().True(s.act.needsSetup())
+}
+
+func (s *pythonActionSuite) TestRunFromScratch() {
+	s.withPythonVersion("3.12.4")
+	s.mockPython.EXPECT().IsPythonInstalled("3.12.4").Return(false)
+	s.mockPython.EXPECT().InstallPython("3.12.4").Return(nil)
+	s.mockPython.EXPECT().HasVirtualenv().Return(false)
+	s.mockPython.EXPECT().CreateVirtualenv("3.12.4").Return(nil)
+	s.mockPython.EXPECT().IsInstallCurrent().Return(false)
+	s.mockPython.EXPECT().InstallDependencies().Return(nil)
+
+	s.Require().NoError(s.act.Run())
+}
+
+func (s *pythonActionSuite) TestRunReusesVirtualenv() {
+	s.withPythonVersion("3.12.4")
+	s.mockPython.EXPECT().IsPythonInstalled("3.12.4").Return(true)
+	s.mockPython.EXPECT().HasVirtualenv().Return(true)
+	s.mockPython.EXPECT().VirtualenvVersion().Return("3.12.4", nil)
+	s.mockPython.EXPECT().IsInstallCurrent().Return(false)
+	s.mockPython.EXPECT().InstallDependencies().Return(nil)
+
+	s.Require().NoError(s.act.Run())
+	s.mockPython.AssertNotCalled(s.T(), "CreateVirtualenv", "3.12.4")
+}
+
+func (s *pythonActionSuite) TestDepsIncludePoetry() {
+	s.mockPython.EXPECT().Package().Return(homebrew.Package{Name: "pyenv"})
+	s.mockPython.EXPECT().DependencyFile().Return("poetry.lock", nil)
+
+	deps := s.act.Deps()
+
+	s.Require().Len(deps, 1)
+	s.Require().Equal("brew-pyenv-poetry", deps[0].Identifier())
+}
+
+func TestPythonActionSuite(t *testing.T) {
+	suite.Run(t, new(pythonActionSuite))
+}
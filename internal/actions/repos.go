@@ -0,0 +1,152 @@
+package actions
+
+import (
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/repos"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+)
+
+// RepoArgs is a sibling repository a project depends on, named org/repo or
+// host/org/repo. URL overrides the clone URL. With Up, gum dev up runs in
+// the repository once it is cloned.
+type RepoArgs struct {
+	Name string `yaml:"name"`
+	URL  string `yaml:"url,omitempty"`
+	Up   bool   `yaml:"up,omitempty"`
+}
+
+type ReposAction struct {
+	source     string
+	args       []RepoArgs
+	repos      func() (repos.Client, error)
+	cmdGen     cmdexec.CmdGenerator
+	executable func() (string, error)
+}
+
+func NewReposAction(source string, args []RepoArgs) *ReposAction {
+	return newReposActionWithComponents(source, args, repos.New, cmdexec.NewCommandGenerator(), os.Executable)
+}
+
+func newReposActionWithComponents(
+	source string,
+	args []RepoArgs,
+	reposClient func() (repos.Client, error),
+	gen cmdexec.CmdGenerator,
+	executable func() (string, error),
+) *ReposAction {
+	return &ReposAction{
+		source:     source,
+		args:       args,
+		repos:      reposClient,
+		cmdGen:     gen,
+		executable: executable,
+	}
+}
+
+func (a *ReposAction) Name() string {
+	return "repos"
+}
+
+func (a *ReposAction) Identifier() string {
+	names := []string{}
+	for _, arg := range a.args {
+		names = append(names, arg.Name)
+	}
+
+	return "repos-" + strings.Join(names, "-")
+}
+
+func (a *ReposAction) IsPublic() bool {
+	return true
+}
+
+func (a *ReposAction) Platforms() []systeminfo.Platform {
+	return []systeminfo.Platform{systeminfo.Darwin, systeminfo.Linux}
+}
+
+func (a *ReposAction) Deps() []Action {
+	return []Action{}
+}
+
+func (a *ReposAction) Validate() error {
+	for _, arg := range a.args {
+		if arg.Name == "" {
+			return errors.Errorf("%s: repository name is required", a.source)
+		}
+
+		if _, err := repos.Parse(arg.Name); err != nil {
+			return errors.Errorf("%s: %s", a.source, err)
+		}
+	}
+
+	return nil
+}
+
+func (a *ReposAction) ShouldRun() bool {
+	client, err := a.repos()
+	if err != nil {
+		return true
+	}
+
+	for _, arg := range a.args {
+		repo, err := repos.Parse(arg.Name)
+		if err != nil || !client.IsCloned(repo) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Run clones the missing repositories. gum dev up only runs in the ones it
+// clones: it is up to their developers to run it again later.
+func (a *ReposAction) Run() error {
+	client, err := a.repos()
+	if err != nil {
+		return err
+	}
+
+	for _, arg := range a.args {
+		repo, err := repos.Parse(arg.Name)
+		if err != nil {
+			return err
+		}
+
+		if client.IsCloned(repo) {
+			log.Debugf("%s is already cloned in %s", repo, client.Path(repo))
+			continue
+		}
+
+		path, err := client.Clone(repo, arg.URL)
+		if err != nil {
+			return err
+		}
+
+		if arg.Up {
+			if err := a.up(path); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (a *ReposAction) up(path string) error {
+	gum, err := a.executable()
+	if err != nil {
+		return errors.Errorf("Unable to get current executable path: %s", err)
+	}
+
+	log.Infof("Running gum dev up in %s", path)
+	if err := a.cmdGen("bash", "-c", `cd "$1" && "$2" dev up`, "gum", path, gum).Run(); err != nil {
+		return errors.Errorf("gum dev up failed in %s, run it there to see the details: %s", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec/fakecmdexec"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/repos"
+	"github.com/renegumroad/gum-cli/internal/repos/mockrepos"
+	"github.com/stretchr/testify/suite"
+)
+
+type reposActionSuite struct {
+	suite.Suite
+	mockRepos *mockrepos.MockClient
+}
+
+func (s *reposActionSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *reposActionSuite) SetupTest() {
+	s.mockRepos = mockrepos.NewMockClient(s.T())
+}
+
+func (s *reposActionSuite) newAction(args []RepoArgs, cmds ...fakecmdexec.SettableCommand) *ReposAction {
+	reposClient := func() (repos.Client, error) {
+		return s.mockRepos, nil
+	}
+	executable := func() (string, error) {
+		return "/opt/gumroad/bin/gum", nil
+	}
+
+	return newReposActionWithComponents("gum.yml up[0]", args, reposClient, fakecmdexec.NewCmdGenerator(cmds...), executable)
+}
+
+func (s *reposActionSuite) TestValidate() {
+	s.Require().EqualError(s.newAction([]RepoArgs{{Name: "web"}}).Validate(), `gum.yml up[0]: Invalid repository "web": expected org/repo, host/org/repo or a clone URL`)
+	s.Require().NoError(s.newAction([]RepoArgs{{Name: "gumroad/web"}}).Validate())
+}
+
+func (s *reposActionSuite) TestShouldRun() {
+	web := &repos.Repo{Host: "github.com", Org: "gumroad", Name: "web"}
+	s.mockRepos.EXPECT().IsCloned(web).Return(true)
+
+	s.Require().False(s.newAction([]RepoArgs{{Name: "gumroad/web"}}).ShouldRun())
+}
+
+func (s *reposActionSuite) TestRunClonesAndSetsUp() {
+	web := &repos.Repo{Host: "github.com", Org: "gumroad", Name: "web"}
+	helper := &repos.Repo{Host: "github.com", Org: "gumroad", Name: "helper"}
+	s.mockRepos.EXPECT().IsCloned(web).Return(true)
+	s.mockRepos.EXPECT().Path(web).Return("/src/github.com/gumroad/web")
+	s.mockRepos.EXPECT().IsCloned(helper).Return(false)
+	s.mockRepos.EXPECT().Clone(helper, "file:///tmp/helper.git").Return("/src/github.com/gumroad/helper", nil)
+	up := fakecmdexec.NewNoOpCommand()
+
+	err := s.newAction([]RepoArgs{
+		{Name: "gumroad/web", Up: true},
+		{Name: "gumroad/helper", URL: "file:///tmp/helper.git", Up: true},
+	}, up).Run()
+
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"-c", `cd "$1" && "$2" dev up`, "gum", "/src/github.com/gumroad/helper", "/opt/gumroad/bin/gum"}, up.Args())
+}
+
+func TestReposActionSuite(t *testing.T) {
+	suite.Run(t, new(reposActionSuite))
+}
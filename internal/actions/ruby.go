@@ -1,17 +1,40 @@
 package actions
 
 import (
+	"github.com/pkg/errors"
 	"github.com/renegumroad/gum-cli/internal/cli/bundler"
 	"github.com/renegumroad/gum-cli/internal/cli/homebrew"
-	"github.com/renegumroad/gum-cli/internal/cli/rbenv"
+	"github.com/renegumroad/gum-cli/internal/cli/versionmanager"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
 	"github.com/renegumroad/gum-cli/internal/systeminfo"
 )
 
 type RubyAction struct {
+	fs         filesystem.Client
+	manager    versionmanager.Client
+	managerErr error
+	bundler    bundler.Client
 }
 
 func NewRubyAction() *RubyAction {
-	return &RubyAction{}
+	manager, err := versionmanager.New()
+
+	return newRubyActionWithComponents(filesystem.New(), manager, err, bundler.New())
+}
+
+func newRubyActionWithComponents(
+	fs filesystem.Client,
+	manager versionmanager.Client,
+	managerErr error,
+	bundClient bundler.Client,
+) *RubyAction {
+	return &RubyAction{
+		fs:         fs,
+		manager:    manager,
+		managerErr: managerErr,
+		bundler:    bundClient,
+	}
 }
 
 func (a *RubyAction) Name() string {
@@ -31,38 +54,114 @@ func (a *RubyAction) Platforms() []systeminfo.Platform {
 }
 
 func (a *RubyAction) Deps() []Action {
+	if a.manager == nil {
+		return []Action{}
+	}
+
 	return []Action{
 		NewBrewAction(
+			"action ruby",
 			[]homebrew.Package{
-				{Name: "rbenv"},
+				a.manager.Package(),
 			}),
 	}
 }
 
 func (a *RubyAction) Validate() error {
+	if a.managerErr != nil {
+		return errors.Errorf("Failed %s action validation: %s", a.Name(), a.managerErr)
+	}
+
+	if !a.manager.Supports(versionmanager.Ruby) {
+		return errors.Errorf("Failed %s action validation: %s cannot install ruby", a.Name(), a.manager.Name())
+	}
+
 	return nil
 }
 
 func (a *RubyAction) ShouldRun() bool {
-	return true
+	return depsShouldRun(a.Deps()) || a.needsSetup()
 }
 
-func (a *RubyAction) Run() error {
-	rbClient := rbenv.New()
+// needsSetup checks the ruby version, the bundler version and the gems.
+func (a *RubyAction) needsSetup() bool {
+	version, err := a.requiredVersion()
+	if err != nil {
+		log.Debugf("Unable to read the ruby version: %s", err)
+		return true
+	}
+
+	if version != nil && !a.manager.IsInstalled(versionmanager.Ruby, version.Version) {
+		log.Debugf("Ruby %s is not installed", version.Version)
+		return true
+	}
+
+	if !a.bundler.HasGemfile() {
+		return false
+	}
+
+	if !a.bundler.IsBundlerInstalled() {
+		log.Debugln("Bundler version is not installed")
+		return true
+	}
+
+	return !a.bundler.IsBundleSatisfied()
+}
 
-	if err := rbClient.EnsureRubyInstalled(); err != nil {
+func (a *RubyAction) Run() error {
+	if err := a.ensureRubyInstalled(); err != nil {
 		return err
 	}
 
-	bundClient := bundler.New()
+	if !a.bundler.HasGemfile() {
+		log.Infof("No Gemfile found, skipping gems installation")
+		return nil
+	}
 
-	if err := bundClient.EnsureBundlerInstalled(); err != nil {
+	if err := a.bundler.EnsureBundlerInstalled(); err != nil {
 		return err
 	}
 
-	if err := bundClient.InstallGems(); err != nil {
+	if a.bundler.IsBundleSatisfied() {
+		log.Infof("Gems are already installed")
+		return nil
+	}
+
+	if err := a.bundler.InstallGems(); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+func (a *RubyAction) ensureRubyInstalled() error {
+	version, err := a.requiredVersion()
+	if err != nil {
+		return err
+	}
+
+	if version == nil {
+		log.Infof("No ruby version declared, using the %s default", a.manager.Name())
+		return nil
+	}
+
+	if a.manager.Name() == versionmanager.Rbenv && version.File != ".ruby-version" {
+		log.Warnf("rbenv does not read %s, add a .ruby-version file so that ruby %s is selected", version.File, version.Version)
+	}
+
+	if a.manager.IsInstalled(versionmanager.Ruby, version.Version) {
+		log.Infof("Ruby %s is already installed", version.Version)
+		return nil
+	}
+
+	return a.manager.Install(versionmanager.Ruby, version.Version)
+}
+
+func (a *RubyAction) requiredVersion() (*versionmanager.Version, error) {
+	dir, err := a.fs.CurrentDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return versionmanager.RequiredVersion(a.fs, dir, versionmanager.Ruby)
+}
@@ -0,0 +1,118 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/bundler/mockbundler"
+	"github.com/renegumroad/gum-cli/internal/cli/versionmanager/mockversionmanager"
+	"github.com/renegumroad/gum-cli/internal/filesystem/mockfilesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type rubyActionSuite struct {
+	suite.Suite
+	mockFs      *mockfilesystem.MockClient
+	mockManager *mockversionmanager.MockClient
+	mockBundler *mockbundler.MockClient
+	act         *RubyAction
+}
+
+func (s *rubyActionSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *rubyActionSuite) SetupTest() {
+	s.mockFs = mockfilesystem.NewMockClient(s.T())
+	s.mockManager = mockversionmanager.NewMockClient(s.T())
+	s.mockBundler = mockbundler.NewMockClient(s.T())
+	s.act = newRubyActionWithComponents(s.mockFs, s.mockManager, nil, s.mockBundler)
+}
+
+func (s *rubyActionSuite) withRubyVersion(version string) {
+	s.mockFs.EXPECT().CurrentDir().Return("/app", nil)
+	s.mockFs.EXPECT().Exists("/app/.ruby-version").Return(true)
+	s.mockFs.EXPECT().ReadString("/app/.ruby-version").Return(version, nil)
+}
+
+func (s *rubyActionSuite) TestValidateManagerError() {
+	act := newRubyActionWithComponents(s.mockFs, nil, errors.New("Unsupported version manager nvm"), s.mockBundler)
+
+	s.Require().ErrorContains(act.Validate(), "Unsupported version manager nvm")
+	s.Require().Empty(act.Deps())
+}
+
+func (s *rubyActionSuite) TestNeedsSetupRubyMissing() {
+	s.withRubyVersion("3.3.4")
+	s.mockManager.EXPECT().IsInstalled("ruby", "3.3.4").Return(false)
+
+	s.Require().True(s.act.needsSetup())
+}
+
+func (s *rubyActionSuite) TestNeedsSetupWithoutGemfile() {
+	s.withRubyVersion("3.3.4")
+	s.mockManager.EXPECT().IsInstalled("ruby", "3.3.4").Return(true)
+	s.mockBundler.EXPECT().HasGemfile().Return(false)
+
+	s.Require().False(s.act.needsSetup())
+}
+
+func (s *rubyActionSuite) TestNeedsSetupBundlerMissing() {
+	s.withRubyVersion("3.3.4")
+	s.mockManager.EXPECT().IsInstalled("ruby", "3.3.4").Return(true)
+	s.mockBundler.EXPECT().HasGemfile().Return(true)
+	s.mockBundler.EXPECT().IsBundlerInstalled().Return(false)
+
+	s.Require().True(s.act.needsSetup())
+}
+
+func (s *rubyActionSuite) TestNeedsSetupBundleSatisfied() {
+	s.withRubyVersion("3.3.4")
+	s.mockManager.EXPECT().IsInstalled("ruby", "3.3.4").Return(true)
+	s.mockBundler.EXPECT().HasGemfile().Return(true)
+	s.mockBundler.EXPECT().IsBundlerInstalled().Return(true)
+	s.mockBundler.EXPECT().IsBundleSatisfied().Return(true)
+
+	s.Require().False(s.act.needsSetup())
+}
+
+func (s *rubyActionSuite) TestRunInstallsRubyThroughManager() {
+	s.withRubyVersion("3.3.4")
+	s.mockManager.EXPECT().Name().Return("mise")
+	s.mockManager.EXPECT().IsInstalled("ruby", "3.3.4").Return(false)
+	s.mockManager.EXPECT().Install("ruby", "3.3.4").Return(nil)
+	s.mockBundler.EXPECT().HasGemfile().Return(false)
+
+	s.Require().NoError(s.act.Run())
+	s.mockBundler.AssertNotCalled(s.T(), "InstallGems")
+}
+
+func (s *rubyActionSuite) TestRunSkipsBundleInstallWhenSatisfied() {
+	s.withRubyVersion("3.3.4")
+	s.mockManager.EXPECT().Name().Return("asdf")
+	s.mockManager.EXPECT().IsInstalled("ruby", "3.3.4").Return(true)
+	s.mockBundler.EXPECT().HasGemfile().Return(true)
+	s.mockBundler.EXPECT().EnsureBundlerInstalled().Return(nil)
+	s.mockBundler.EXPECT().IsBundleSatisfied().Return(true)
+
+	s.Require().NoError(s.act.Run())
+	s.mockBundler.AssertNotCalled(s.T(), "InstallGems")
+}
+
+func (s *rubyActionSuite) TestRunInstallsGems() {
+	s.withRubyVersion("3.3.4")
+	s.mockManager.EXPECT().Name().Return("rbenv")
+	s.mockManager.EXPECT().IsInstalled("ruby", "3.3.4").Return(true)
+	s.mockBundler.EXPECT().HasGemfile().Return(true)
+	s.mockBundler.EXPECT().EnsureBundlerInstalled().Return(nil)
+	s.mockBundler.EXPECT().IsBundleSatisfied().Return(false)
+	s.mockBundler.EXPECT().InstallGems().Return(nil)
+
+	s.Require().NoError(s.act.Run())
+}
+
+func TestRubyActionSuite(t *testing.T) {
+	suite.Run(t, new(rubyActionSuite))
+}
@@ -0,0 +1,253 @@
+package actions
+
+import (
+	"path/filepath"
+	"slices"
+
+	"github.com/renegumroad/gum-cli/internal/cli/homebrew"
+	"github.com/renegumroad/gum-cli/internal/cli/rustup"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+)
+
+const defaultRustChannel = "stable"
+
+var (
+	rustToolchainFiles = []string{"rust-toolchain.toml", "rust-toolchain"}
+
+	rustSchema = Schema{
+		{
+			Name:        "toolchain",
+			Type:        StringParam,
+			Description: "Toolchain installed when the project has no rust-toolchain.toml. Defaults to stable.",
+		},
+		{
+			Name:        "fetch",
+			Type:        BoolParam,
+			Description: "Run cargo fetch for projects with a Cargo.toml. Defaults to false.",
+		},
+	}
+)
+
+// RustArgs configures the rust action.
+type RustArgs struct {
+	Toolchain string `yaml:"toolchain"`
+	Fetch     bool   `yaml:"fetch"`
+}
+
+// rustSetup is what is missing from the required toolchain.
+type rustSetup struct {
+	toolchain  bool
+	components []string
+	targets    []string
+	setDefault bool
+}
+
+func (s rustSetup) isComplete() bool {
+	return !s.toolchain && len(s.components) == 0 && len(s.targets) == 0 && !s.setDefault
+}
+
+type RustAction struct {
+	args   RustArgs
+	fs     filesystem.Client
+	rustup rustup.Client
+}
+
+func NewRustAction(args RustArgs) *RustAction {
+	return newRustActionWithComponents(args, filesystem.New(), rustup.New())
+}
+
+func newRustActionWithComponents(args RustArgs, fs filesystem.Client, rustupClient rustup.Client) *RustAction {
+	if args.Toolchain == "" {
+		args.Toolchain = defaultRustChannel
+	}
+
+	return &RustAction{
+		args:   args,
+		fs:     fs,
+		rustup: rustupClient,
+	}
+}
+
+func (a *RustAction) Name() string {
+	return "rust"
+}
+
+func (a *RustAction) Identifier() string {
+	return "rust"
+}
+
+func (a *RustAction) IsPublic() bool {
+	return true
+}
+
+func (a *RustAction) Platforms() []systeminfo.Platform {
+	return []systeminfo.Platform{systeminfo.Darwin, systeminfo.Linux}
+}
+
+func (a *RustAction) Deps() []Action {
+	return []Action{
+		// the rustup formula is keg-only so that it doesn't conflict with rust
+		NewBrewAction("action rust", []homebrew.Package{{Name: "rustup", Link: true}}),
+	}
+}
+
+func (a *RustAction) Validate() error {
+	return nil
+}
+
+func (a *RustAction) ShouldRun() bool {
+	return depsShouldRun(a.Deps()) || a.needsSetup()
+}
+
+// needsSetup compares the required toolchain with `rustup show` and checks
+// whether the cargo dependencies are fetched.
+func (a *RustAction) needsSetup() bool {
+	toolchain, fromFile, err := a.toolchain()
+	if err != nil {
+		log.Debugf("Unable to read the rust toolchain: %s", err)
+		return true
+	}
+
+	setup, err := a.missing(toolchain, fromFile)
+	if err != nil {
+		log.Debugf("%s", err)
+		return true
+	}
+
+	if !setup.isComplete() {
+		return true
+	}
+
+	return a.shouldFetch() && !a.rustup.IsFetched()
+}
+
+func (a *RustAction) Run() error {
+	toolchain, fromFile, err := a.toolchain()
+	if err != nil {
+		return err
+	}
+
+	setup, err := a.missing(toolchain, fromFile)
+	if err != nil {
+		return err
+	}
+
+	if setup.isComplete() {
+		log.Infof("Rust toolchain %s is already installed", toolchain.Channel)
+	}
+
+	if setup.toolchain {
+		if err := a.rustup.InstallToolchain(toolchain); err != nil {
+			return err
+		}
+	}
+
+	if len(setup.components) > 0 {
+		if err := a.rustup.AddComponents(toolchain.Channel, setup.components); err != nil {
+			return err
+		}
+	}
+
+	if len(setup.targets) > 0 {
+		if err := a.rustup.AddTargets(toolchain.Channel, setup.targets); err != nil {
+			return err
+		}
+	}
+
+	if setup.setDefault {
+		if err := a.rustup.SetDefault(toolchain.Channel); err != nil {
+			return err
+		}
+	}
+
+	if !a.shouldFetch() || a.rustup.IsFetched() {
+		return nil
+	}
+
+	return a.rustup.Fetch()
+}
+
+// missing lists the parts of the toolchain that are not installed. Without
+// a toolchain file, the toolchain is also made the default when there is
+// none, so that cargo works in the project.
+func (a *RustAction) missing(toolchain *rustup.Toolchain, fromFile bool) (rustSetup, error) {
+	setup := rustSetup{}
+
+	show, err := a.rustup.Show()
+	if err != nil {
+		return setup, err
+	}
+
+	setup.setDefault = !fromFile && show.Default == ""
+
+	if !show.HasToolchain(toolchain.Channel) {
+		log.Debugf("Rust toolchain %s is not installed", toolchain.Channel)
+		setup.toolchain = true
+		return setup, nil
+	}
+
+	if len(toolchain.Components) > 0 {
+		installed, err := a.rustup.InstalledComponents(toolchain.Channel)
+		if err != nil {
+			return setup, err
+		}
+
+		for _, component := range toolchain.Components {
+			if !slices.Contains(installed, component) && !slices.Contains(installed, component+"-"+show.Host) {
+				setup.components = append(setup.components, component)
+			}
+		}
+	}
+
+	// rustup show only lists the targets of the active toolchain
+	active := show.Active == toolchain.Channel || show.Active == toolchain.Channel+"-"+show.Host
+	for _, target := range toolchain.Targets {
+		if !active || !slices.Contains(show.Targets, target) {
+			setup.targets = append(setup.targets, target)
+		}
+	}
+
+	return setup, nil
+}
+
+// toolchain reads the toolchain file of the current directory, falling back
+// to the toolchain parameter.
+func (a *RustAction) toolchain() (*rustup.Toolchain, bool, error) {
+	dir, err := a.fs.CurrentDir()
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, name := range rustToolchainFiles {
+		path := filepath.Join(dir, name)
+		if !a.fs.Exists(path) {
+			continue
+		}
+
+		content, err := a.fs.ReadString(path)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if toolchain := rustup.ParseToolchainFile(content); toolchain.Channel != "" {
+			return toolchain, true, nil
+		}
+	}
+
+	return &rustup.Toolchain{Channel: a.args.Toolchain}, false, nil
+}
+
+func (a *RustAction) shouldFetch() bool {
+	if !a.args.Fetch {
+		return false
+	}
+
+	dir, err := a.fs.CurrentDir()
+	if err != nil {
+		return false
+	}
+
+	return a.fs.Exists(filepath.Join(dir, "Cargo.toml"))
+}
@@ -0,0 +1,115 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/cli/rustup"
+	"github.com/renegumroad/gum-cli/internal/cli/rustup/mockrustup"
+	"github.com/renegumroad/gum-cli/internal/filesystem/mockfilesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const rustTestToolchainFile = `[toolchain]
+channel = "1.79.0"
+components = ["rustfmt", "clippy"]
+targets = ["wasm32-unknown-unknown"]
+`
+
+type rustActionSuite struct {
+	suite.Suite
+	mockFs     *mockfilesystem.MockClient
+	mockRustup *mockrustup.MockClient
+}
+
+func (s *rustActionSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *rustActionSuite) SetupTest() {
+	s.mockFs = mockfilesystem.NewMockClient(s.T())
+	s.mockRustup = mockrustup.NewMockClient(s.T())
+	s.mockFs.EXPECT().CurrentDir().Return("/app", nil).Maybe()
+}
+
+func (s *rustActionSuite) newAction(args RustArgs) *RustAction {
+	return newRustActionWithComponents(args, s.mockFs, s.mockRustup)
+}
+
+func (s *rustActionSuite) withFiles(files map[string]string) {
+	s.mockFs.EXPECT().Exists(mock.Anything).RunAndReturn(func(path string) bool {
+		_, found := files[path]
+		return found
+	}).Maybe()
+
+	for path, content := range files {
+		s.mockFs.EXPECT().ReadString(path).Return(content, nil).Maybe()
+	}
+}
+
+func (s *rustActionSuite) installedShow() *rustup.Show {
+	return &rustup.Show{
+		Host:       "x86_64-apple-darwin",
+		Toolchains: []string{"stable-x86_64-apple-darwin", "1.79.0-x86_64-apple-darwin"},
+		Default:    "stable-x86_64-apple-darwin",
+		Active:     "1.79.0-x86_64-apple-darwin",
+		Targets:    []string{"x86_64-apple-darwin", "wasm32-unknown-unknown"},
+	}
+}
+
+func (s *rustActionSuite) TestNeedsSetupEverythingInstalled() {
+	s.withFiles(map[string]string{"/app/rust-toolchain.toml": rustTestToolchainFile})
+	s.mockRustup.EXPECT().Show().Return(s.installedShow(), nil)
+	s.mockRustup.EXPECT().InstalledComponents("1.79.0").Return([]string{"cargo-x86_64-apple-darwin", "clippy-x86_64-apple-darwin", "rustfmt-x86_64-apple-darwin"}, nil)
+
+	s.Require().False(s.newAction(RustArgs{}).needsSetup())
+}
+
+func (s *rustActionSuite) TestRunInstallsMissingToolchain() {
+	s.withFiles(map[string]string{"/app/rust-toolchain.toml": rustTestToolchainFile})
+	s.mockRustup.EXPECT().Show().Return(&rustup.Show{Host: "x86_64-apple-darwin", Default: "stable-x86_64-apple-darwin"}, nil)
+	s.mockRustup.EXPECT().InstallToolchain(&rustup.Toolchain{
+		Channel:    "1.79.0",
+		Components: []string{"rustfmt", "clippy"},
+		Targets:    []string{"wasm32-unknown-unknown"},
+	}).Return(nil)
+
+	s.Require().NoError(s.newAction(RustArgs{}).Run())
+}
+
+func (s *rustActionSuite) TestRunAddsMissingComponentsAndTargets() {
+	show := s.installedShow()
+	show.Targets = []string{"x86_64-apple-darwin"}
+	s.withFiles(map[string]string{"/app/rust-toolchain.toml": rustTestToolchainFile})
+	s.mockRustup.EXPECT().Show().Return(show, nil)
+	s.mockRustup.EXPECT().InstalledComponents("1.79.0").Return([]string{"rustfmt-x86_64-apple-darwin"}, nil)
+	s.mockRustup.EXPECT().AddComponents("1.79.0", []string{"clippy"}).Return(nil)
+	s.mockRustup.EXPECT().AddTargets("1.79.0", []string{"wasm32-unknown-unknown"}).Return(nil)
+
+	s.Require().NoError(s.newAction(RustArgs{}).Run())
+	s.mockRustup.AssertNotCalled(s.T(), "InstallToolchain", mock.Anything)
+}
+
+func (s *rustActionSuite) TestRunWithoutToolchainFileSetsDefault() {
+	s.withFiles(map[string]string{})
+	s.mockRustup.EXPECT().Show().Return(&rustup.Show{Host: "x86_64-unknown-linux-gnu", Toolchains: []string{}}, nil)
+	s.mockRustup.EXPECT().InstallToolchain(&rustup.Toolchain{Channel: "stable"}).Return(nil)
+	s.mockRustup.EXPECT().SetDefault("stable").Return(nil)
+
+	s.Require().NoError(s.newAction(RustArgs{}).Run())
+}
+
+func (s *rustActionSuite) TestRunFetchesCargoDependencies() {
+	s.withFiles(map[string]string{"/app/rust-toolchain": "1.79.0\n", "/app/Cargo.toml": ""})
+	s.mockRustup.EXPECT().Show().Return(s.installedShow(), nil)
+	s.mockRustup.EXPECT().IsFetched().Return(false)
+	s.mockRustup.EXPECT().Fetch().Return(nil)
+
+	s.Require().NoError(s.newAction(RustArgs{Fetch: true}).Run())
+}
+
+func TestRustActionSuite(t *testing.T) {
+	suite.Run(t, new(rustActionSuite))
+}
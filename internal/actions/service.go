@@ -0,0 +1,234 @@
+package actions
+
+import (
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/homebrew"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+)
+
+const (
+	defaultServiceHost    = "127.0.0.1"
+	defaultServiceTimeout = 30 * time.Second
+	servicePollInterval   = 500 * time.Millisecond
+)
+
+// ServiceArgs describes a brew-managed background service. Port is optional;
+// when set, the action waits until the port accepts TCP connections.
+type ServiceArgs struct {
+	Name    string        `yaml:"name"`
+	Host    string        `yaml:"host,omitempty"`
+	Port    int           `yaml:"port,omitempty"`
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+type ServiceAction struct {
+	source   string
+	services []ServiceArgs
+	brew     homebrew.Client
+	dial     func(addr string, timeout time.Duration) error
+	interval time.Duration
+}
+
+func NewServiceAction(source string, services []ServiceArgs) *ServiceAction {
+	return newServiceActionWithComponents(source, services, homebrew.Default(), dialTCP)
+}
+
+func newServiceActionWithComponents(
+	source string,
+	services []ServiceArgs,
+	brew homebrew.Client,
+	dial func(addr string, timeout time.Duration) error,
+) *ServiceAction {
+	return &ServiceAction{
+		source:   source,
+		services: services,
+		brew:     brew,
+		dial:     dial,
+		interval: servicePollInterval,
+	}
+}
+
+func (a *ServiceAction) Name() string {
+	return "services"
+}
+
+func (a *ServiceAction) Identifier() string {
+	id := "services"
+	for _, svc := range a.services {
+		id += "-" + svc.Name
+	}
+
+	return id
+}
+
+func (a *ServiceAction) IsPublic() bool {
+	return true
+}
+
+func (a *ServiceAction) Platforms() []systeminfo.Platform {
+	return []systeminfo.Platform{systeminfo.Darwin, systeminfo.Linux}
+}
+
+func (a *ServiceAction) Deps() []Action {
+	packages := []homebrew.Package{}
+	for _, svc := range a.services {
+		packages = append(packages, homebrew.Package{Name: svc.Name})
+	}
+
+	return []Action{
+		newBrewActionWithClient(a.source, packages, a.brew),
+	}
+}
+
+func (a *ServiceAction) Validate() error {
+	if len(a.services) == 0 {
+		return errors.Errorf("Failed %s action validation: no services specified.", a.Name())
+	}
+
+	for _, svc := range a.services {
+		if svc.Name == "" {
+			return errors.Errorf("Failed %s action validation: service name is required.", a.Name())
+		}
+
+		if svc.Port < 0 || svc.Port > 65535 {
+			return errors.Errorf("Failed %s action validation: invalid port %d for service %s.", a.Name(), svc.Port, svc.Name)
+		}
+	}
+
+	return nil
+}
+
+func (a *ServiceAction) ShouldRun() bool {
+	if depsShouldRun(a.Deps()) {
+		return true
+	}
+
+	running, err := a.runningServices()
+	if err != nil {
+		log.Debugf("Unable to list brew services: %s", err)
+		return true
+	}
+
+	for _, svc := range a.services {
+		if !running[svc.Name] {
+			return true
+		}
+
+		if svc.Port != 0 && a.dial(serviceAddress(svc), time.Second) != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *ServiceAction) Run() error {
+	running, err := a.runningServices()
+	if err != nil {
+		return err
+	}
+
+	for _, svc := range a.services {
+		if running[svc.Name] {
+			log.Infof("Service %s is already running", svc.Name)
+		} else if err := a.brew.StartService(svc.Name); err != nil {
+			return err
+		}
+	}
+
+	for _, svc := range a.services {
+		if svc.Port == 0 {
+			continue
+		}
+
+		if err := a.waitForPort(svc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Stop stops every declared service that is currently running.
+func (a *ServiceAction) Stop() error {
+	running, err := a.runningServices()
+	if err != nil {
+		return err
+	}
+
+	for _, svc := range a.services {
+		if !running[svc.Name] {
+			log.Infof("Service %s is not running", svc.Name)
+			continue
+		}
+
+		if err := a.brew.StopService(svc.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *ServiceAction) runningServices() (map[string]bool, error) {
+	services, err := a.brew.Services()
+	if err != nil {
+		return nil, err
+	}
+
+	running := map[string]bool{}
+	for _, svc := range services {
+		running[svc.Name] = svc.IsRunning()
+	}
+
+	return running, nil
+}
+
+func (a *ServiceAction) waitForPort(svc ServiceArgs) error {
+	addr := serviceAddress(svc)
+	timeout := svc.Timeout
+	if timeout == 0 {
+		timeout = defaultServiceTimeout
+	}
+
+	log.Infof("Waiting for %s to accept connections on %s", svc.Name, addr)
+
+	deadline := time.Now().Add(timeout)
+	for {
+		err := a.dial(addr, time.Second)
+		if err == nil {
+			log.Infof("Service %s is accepting connections on %s", svc.Name, addr)
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return errors.Errorf("Service %s did not accept connections on %s after %s: %s", svc.Name, addr, timeout, err)
+		}
+
+		log.Debugf("Service %s is not ready yet: %s", svc.Name, err)
+		time.Sleep(a.interval)
+	}
+}
+
+func serviceAddress(svc ServiceArgs) string {
+	host := svc.Host
+	if host == "" {
+		host = defaultServiceHost
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(svc.Port))
+}
+
+func dialTCP(addr string, timeout time.Duration) error {
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		return err
+	}
+
+	return conn.Close()
+}
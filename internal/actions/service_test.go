@@ -0,0 +1,125 @@
+package actions
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/homebrew"
+	"github.com/renegumroad/gum-cli/internal/cli/homebrew/mockhomebrew"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type serviceActionSuite struct {
+	suite.Suite
+	mockBrew *mockhomebrew.MockClient
+}
+
+func (s *serviceActionSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *serviceActionSuite) SetupTest() {
+	s.mockBrew = mockhomebrew.NewMockClient(s.T())
+}
+
+func (s *serviceActionSuite) TestValidate() {
+	act := NewServiceAction("gum.yml up[0]", []ServiceArgs{})
+	s.Require().ErrorContains(act.Validate(), "no services specified")
+
+	act = NewServiceAction("gum.yml up[0]", []ServiceArgs{{Name: ""}})
+	s.Require().ErrorContains(act.Validate(), "service name is required")
+
+	act = NewServiceAction("gum.yml up[0]", []ServiceArgs{{Name: "redis", Port: 70000}})
+	s.Require().ErrorContains(act.Validate(), "invalid port 70000")
+}
+
+func (s *serviceActionSuite) TestDepsInstallFormulas() {
+	act := newServiceActionWithComponents("gum.yml up[1]", []ServiceArgs{{Name: "redis"}, {Name: "memcached"}}, s.mockBrew, dialTCP)
+
+	deps := act.Deps()
+	s.Require().Len(deps, 1)
+
+	brewAction, ok := deps[0].(*BrewAction)
+	s.Require().True(ok)
+	s.Require().Equal([]homebrew.Package{{Name: "redis"}, {Name: "memcached"}}, brewAction.packages())
+}
+
+func (s *serviceActionSuite) TestRunStartsStoppedServices() {
+	s.mockBrew.EXPECT().Services().Return([]homebrew.Service{
+		{Name: "redis", Status: "started"},
+		{Name: "memcached", Status: "none"},
+	}, nil)
+	s.mockBrew.EXPECT().StartService("memcached").Return(nil)
+
+	act := newServiceActionWithComponents("gum.yml up[0]", []ServiceArgs{{Name: "redis"}, {Name: "memcached"}}, s.mockBrew, dialTCP)
+
+	s.Require().NoError(act.Run())
+	s.mockBrew.AssertNotCalled(s.T(), "StartService", "redis")
+}
+
+func (s *serviceActionSuite) TestRunWaitsForPort() {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	defer listener.Close()
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	s.mockBrew.EXPECT().Services().Return([]homebrew.Service{{Name: "postgresql@16", Status: "started"}}, nil)
+
+	act := newServiceActionWithComponents("gum.yml up[0]", []ServiceArgs{{Name: "postgresql@16", Port: port}}, s.mockBrew, dialTCP)
+
+	s.Require().NoError(act.Run())
+}
+
+func (s *serviceActionSuite) TestRunRetriesUntilPortIsReady() {
+	attempts := 0
+	dial := func(addr string, _ time.Duration) error {
+		s.Require().Equal("127.0.0.1:6379", addr)
+		attempts++
+		if attempts < 3 {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+	s.mockBrew.EXPECT().Services().Return([]homebrew.Service{}, nil)
+	s.mockBrew.EXPECT().StartService("redis").Return(nil)
+
+	act := newServiceActionWithComponents("gum.yml up[0]", []ServiceArgs{{Name: "redis", Port: 6379}}, s.mockBrew, dial)
+	act.interval = time.Millisecond
+
+	s.Require().NoError(act.Run())
+	s.Require().Equal(3, attempts)
+}
+
+func (s *serviceActionSuite) TestRunTimesOutWaitingForPort() {
+	dial := func(_ string, _ time.Duration) error {
+		return errors.New("connection refused")
+	}
+	s.mockBrew.EXPECT().Services().Return([]homebrew.Service{{Name: "redis", Status: "started"}}, nil)
+
+	act := newServiceActionWithComponents("gum.yml up[0]", []ServiceArgs{{Name: "redis", Port: 6379, Timeout: 10 * time.Millisecond}}, s.mockBrew, dial)
+	act.interval = time.Millisecond
+
+	err := act.Run()
+	s.Require().ErrorContains(err, "Service redis did not accept connections on 127.0.0.1:6379")
+}
+
+func (s *serviceActionSuite) TestStopOnlyStopsRunningServices() {
+	s.mockBrew.EXPECT().Services().Return([]homebrew.Service{
+		{Name: "redis", Status: "started"},
+		{Name: "memcached", Status: "stopped"},
+	}, nil)
+	s.mockBrew.EXPECT().StopService("redis").Return(nil)
+
+	act := newServiceActionWithComponents("gum.yml up[0]", []ServiceArgs{{Name: "redis"}, {Name: "memcached"}}, s.mockBrew, dialTCP)
+
+	s.Require().NoError(act.Stop())
+	s.mockBrew.AssertNumberOfCalls(s.T(), "StopService", 1)
+}
+
+func TestServiceActionSuite(t *testing.T) {
+	suite.Run(t, new(serviceActionSuite))
+}
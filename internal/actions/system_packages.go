@@ -0,0 +1,146 @@
+package actions
+
+import (
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/pkgmanager"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+)
+
+// SystemPackagesArgs lists distribution packages by package manager, since
+// the same dependency usually has a different name on each distribution.
+type SystemPackagesArgs struct {
+	Apt    []string `yaml:"apt,omitempty"`
+	Dnf    []string `yaml:"dnf,omitempty"`
+	Pacman []string `yaml:"pacman,omitempty"`
+}
+
+func (args *SystemPackagesArgs) IsEmpty() bool {
+	return len(args.Apt) == 0 && len(args.Dnf) == 0 && len(args.Pacman) == 0
+}
+
+func (args *SystemPackagesArgs) packagesFor(manager string) []string {
+	switch manager {
+	case "apt":
+		return args.Apt
+	case "dnf":
+		return args.Dnf
+	case "pacman":
+		return args.Pacman
+	}
+
+	return nil
+}
+
+type SystemPackagesAction struct {
+	args       []*SystemPackagesArgs
+	manager    pkgmanager.Client
+	managerErr error
+}
+
+func NewSystemPackagesAction(args *SystemPackagesArgs) *SystemPackagesAction {
+	manager, err := pkgmanager.New()
+
+	return newSystemPackagesActionWithComponents(args, manager, err)
+}
+
+func newSystemPackagesActionWithComponents(args *SystemPackagesArgs, manager pkgmanager.Client, managerErr error) *SystemPackagesAction {
+	return &SystemPackagesAction{
+		args:       []*SystemPackagesArgs{args},
+		manager:    manager,
+		managerErr: managerErr,
+	}
+}
+
+func (a *SystemPackagesAction) Name() string {
+	return "system_packages"
+}
+
+func (a *SystemPackagesAction) Identifier() string {
+	id := "system_packages"
+	if a.manager == nil {
+		return id
+	}
+
+	for _, pkg := range a.packages() {
+		id += "-" + pkg
+	}
+
+	return id
+}
+
+func (a *SystemPackagesAction) IsPublic() bool {
+	return true
+}
+
+func (a *SystemPackagesAction) Platforms() []systeminfo.Platform {
+	return []systeminfo.Platform{systeminfo.Linux}
+}
+
+func (a *SystemPackagesAction) Deps() []Action {
+	return []Action{}
+}
+
+func (a *SystemPackagesAction) Validate() error {
+	for _, args := range a.args {
+		if args == nil || args.IsEmpty() {
+			return errors.Errorf("Failed %s action validation: no packages specified.", a.Name())
+		}
+	}
+
+	if a.managerErr != nil {
+		return errors.Errorf("Failed %s action validation: %s", a.Name(), a.managerErr)
+	}
+
+	return nil
+}
+
+func (a *SystemPackagesAction) ShouldRun() bool {
+	packages := a.packages()
+	if len(packages) == 0 {
+		log.Debugf("No %s packages declared for %s", a.manager.Name(), a.Name())
+		return false
+	}
+
+	for _, pkg := range packages {
+		if !a.manager.IsInstalled(pkg) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (a *SystemPackagesAction) Run() error {
+	return a.manager.EnsureInstalledAll(a.packages())
+}
+
+func (a *SystemPackagesAction) merged(other Action) Action {
+	args := append([]*SystemPackagesArgs{}, a.args...)
+
+	return &SystemPackagesAction{
+		args:       append(args, other.(*SystemPackagesAction).args...),
+		manager:    a.manager,
+		managerErr: a.managerErr,
+	}
+}
+
+// packages returns the de-duplicated packages declared for the current
+// package manager, in the order in which they were first declared.
+func (a *SystemPackagesAction) packages() []string {
+	seen := map[string]bool{}
+	packages := []string{}
+
+	for _, args := range a.args {
+		for _, pkg := range args.packagesFor(a.manager.Name()) {
+			if seen[pkg] {
+				continue
+			}
+
+			seen[pkg] = true
+			packages = append(packages, pkg)
+		}
+	}
+
+	return packages
+}
@@ -0,0 +1,73 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/pkgmanager/mockpkgmanager"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type systemPackagesActionSuite struct {
+	suite.Suite
+	mockManager *mockpkgmanager.MockClient
+}
+
+func (s *systemPackagesActionSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *systemPackagesActionSuite) SetupTest() {
+	s.mockManager = mockpkgmanager.NewMockClient(s.T())
+}
+
+func (s *systemPackagesActionSuite) TestValidateNoPackages() {
+	act := newSystemPackagesActionWithComponents(&SystemPackagesArgs{}, s.mockManager, nil)
+
+	s.Require().ErrorContains(act.Validate(), "no packages specified")
+}
+
+func (s *systemPackagesActionSuite) TestValidateUnsupportedDistro() {
+	act := newSystemPackagesActionWithComponents(&SystemPackagesArgs{Apt: []string{"curl"}}, nil, errors.New("No supported package manager for distribution alpine"))
+
+	s.Require().ErrorContains(act.Validate(), "No supported package manager for distribution alpine")
+}
+
+func (s *systemPackagesActionSuite) TestShouldRunWithoutPackagesForManager() {
+	s.mockManager.EXPECT().Name().Return("pacman")
+	act := newSystemPackagesActionWithComponents(&SystemPackagesArgs{Apt: []string{"libpq-dev"}}, s.mockManager, nil)
+
+	s.Require().NoError(act.Validate())
+	s.Require().False(act.ShouldRun())
+}
+
+func (s *systemPackagesActionSuite) TestShouldRun() {
+	s.mockManager.EXPECT().Name().Return("apt")
+	s.mockManager.EXPECT().IsInstalled("build-essential").Return(true)
+	s.mockManager.EXPECT().IsInstalled("libpq-dev").Return(false)
+	act := newSystemPackagesActionWithComponents(&SystemPackagesArgs{
+		Apt: []string{"build-essential", "libpq-dev"},
+		Dnf: []string{"gcc", "libpq-devel"},
+	}, s.mockManager, nil)
+
+	s.Require().True(act.ShouldRun())
+}
+
+func (s *systemPackagesActionSuite) TestMergedRunInstallsInOneBatch() {
+	s.mockManager.EXPECT().Name().Return("dnf")
+	s.mockManager.EXPECT().EnsureInstalledAll([]string{"gcc", "libpq-devel", "libyaml-devel"}).Return(nil)
+
+	actions := mergeActions([]Action{
+		newSystemPackagesActionWithComponents(&SystemPackagesArgs{Dnf: []string{"gcc", "libpq-devel"}}, s.mockManager, nil),
+		newSystemPackagesActionWithComponents(&SystemPackagesArgs{Dnf: []string{"libpq-devel", "libyaml-devel"}}, s.mockManager, nil),
+	})
+
+	s.Require().Len(actions, 1)
+	s.Require().NoError(actions[0].Run())
+}
+
+func TestSystemPackagesActionSuite(t *testing.T) {
+	suite.Run(t, new(systemPackagesActionSuite))
+}
@@ -0,0 +1,242 @@
+package actions
+
+import (
+	"os/user"
+	"path/filepath"
+	"runtime"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/assets"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+	"github.com/renegumroad/gum-cli/internal/templates"
+)
+
+// TemplateArgs renders Source, relative to the project, or Embedded, a
+// template bundled with gum such as rails/database.yml, to Output.
+type TemplateArgs struct {
+	Source   string                 `yaml:"source,omitempty"`
+	Embedded string                 `yaml:"embedded,omitempty"`
+	Output   string                 `yaml:"output"`
+	Vars     map[string]interface{} `yaml:"vars,omitempty"`
+}
+
+type TemplateAction struct {
+	source   string
+	args     *TemplateArgs
+	fs       filesystem.Client
+	sys      systeminfo.Client
+	asset    func(name string) ([]byte, error)
+	username func() (string, error)
+}
+
+func NewTemplateAction(source string, args *TemplateArgs) *TemplateAction {
+	sys := systeminfo.New()
+
+	return newTemplateActionWithComponents(source, args, filesystem.New(), sys, assets.GetAsset, func() (string, error) {
+		return currentUsername(sys)
+	})
+}
+
+func newTemplateActionWithComponents(
+	source string,
+	args *TemplateArgs,
+	fs filesystem.Client,
+	sys systeminfo.Client,
+	asset func(name string) ([]byte, error),
+	username func() (string, error),
+) *TemplateAction {
+	return &TemplateAction{
+		source:   source,
+		args:     args,
+		fs:       fs,
+		sys:      sys,
+		asset:    asset,
+		username: username,
+	}
+}
+
+func (a *TemplateAction) Name() string {
+	return "template"
+}
+
+func (a *TemplateAction) Identifier() string {
+	return "template-" + a.args.Output
+}
+
+func (a *TemplateAction) IsPublic() bool {
+	return true
+}
+
+func (a *TemplateAction) Platforms() []systeminfo.Platform {
+	return []systeminfo.Platform{systeminfo.Darwin, systeminfo.Linux}
+}
+
+func (a *TemplateAction) Deps() []Action {
+	return []Action{}
+}
+
+func (a *TemplateAction) Validate() error {
+	if (a.args.Source == "") == (a.args.Embedded == "") {
+		return errors.Errorf("%s: templates require either a source or an embedded template", a.source)
+	}
+
+	if a.args.Output == "" {
+		return errors.Errorf("%s: template output is required", a.source)
+	}
+
+	if a.args.Embedded != "" {
+		if _, err := a.asset(embeddedTemplatePath(a.args.Embedded)); err != nil {
+			return errors.Errorf("%s: gum has no %s template", a.source, a.args.Embedded)
+		}
+	}
+
+	return nil
+}
+
+func (a *TemplateAction) ShouldRun() bool {
+	output, rendered, err := a.render()
+	if err != nil {
+		log.Debugf("%s", err)
+		return true
+	}
+
+	current, err := a.current(output)
+	return err != nil || current != rendered
+}
+
+// Run writes the rendered template when it differs from the output, and
+// shows what changed.
+func (a *TemplateAction) Run() error {
+	output, rendered, err := a.render()
+	if err != nil {
+		return err
+	}
+
+	current, err := a.current(output)
+	if err != nil {
+		return err
+	}
+
+	if current == rendered {
+		log.Debugf("%s is up to date", output)
+		return nil
+	}
+
+	if a.fs.Exists(output) {
+		log.Infof("Updating %s:\n%s", a.args.Output, templates.Diff(a.args.Output, current, rendered))
+	} else {
+		log.Infof("Writing %s", a.args.Output)
+		if err := a.fs.MkdirAll(filepath.Dir(output)); err != nil {
+			return err
+		}
+	}
+
+	return a.fs.WriteStringAtomic(output, rendered)
+}
+
+// render returns the output path and the rendered template.
+func (a *TemplateAction) render() (string, string, error) {
+	dir, err := a.fs.CurrentDir()
+	if err != nil {
+		return "", "", err
+	}
+
+	content, err := a.template(dir)
+	if err != nil {
+		return "", "", err
+	}
+
+	data, err := a.data(dir)
+	if err != nil {
+		return "", "", err
+	}
+
+	rendered, err := templates.Render(a.templateName(), content, data)
+	if err != nil {
+		return "", "", errors.Errorf("%s: %s", a.source, err)
+	}
+
+	return filepath.Join(dir, a.args.Output), rendered, nil
+}
+
+func (a *TemplateAction) template(dir string) (string, error) {
+	if a.args.Embedded != "" {
+		content, err := a.asset(embeddedTemplatePath(a.args.Embedded))
+		if err != nil {
+			return "", errors.Errorf("%s: gum has no %s template", a.source, a.args.Embedded)
+		}
+
+		return string(content), nil
+	}
+
+	path := filepath.Join(dir, a.args.Source)
+	if !a.fs.IsFile(path) {
+		return "", errors.Errorf("%s: template %s does not exist", a.source, a.args.Source)
+	}
+
+	return a.fs.ReadString(path)
+}
+
+func (a *TemplateAction) data(dir string) (*templates.Data, error) {
+	homeDir, err := a.fs.HomeDir()
+	if err != nil {
+		return nil, err
+	}
+
+	username, err := a.username()
+	if err != nil {
+		return nil, err
+	}
+
+	vars := a.args.Vars
+	if vars == nil {
+		vars = map[string]interface{}{}
+	}
+
+	return &templates.Data{
+		Vars:       vars,
+		User:       username,
+		Home:       homeDir,
+		OS:         a.sys.CurrentPlatform(),
+		Arch:       runtime.GOARCH,
+		GumHome:    filepath.Join(homeDir, ".gum"),
+		ProjectDir: dir,
+	}, nil
+}
+
+// current returns the content of the output, empty when it doesn't exist.
+func (a *TemplateAction) current(output string) (string, error) {
+	if !a.fs.Exists(output) {
+		return "", nil
+	}
+
+	return a.fs.ReadString(output)
+}
+
+func (a *TemplateAction) templateName() string {
+	if a.args.Embedded != "" {
+		return a.args.Embedded
+	}
+
+	return a.args.Source
+}
+
+func embeddedTemplatePath(name string) string {
+	return "templates/" + name + ".tmpl"
+}
+
+// currentUsername is the user running sudo, or the current user.
+func currentUsername(sys systeminfo.Client) (string, error) {
+	if sys.IsSudo() {
+		return sys.GetSudoUsername(), nil
+	}
+
+	current, err := user.Current()
+	if err != nil {
+		return "", errors.Errorf("Unable to get the current user: %s", err)
+	}
+
+	return current.Username, nil
+}
@@ -0,0 +1,102 @@
+package actions
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/renegumroad/gum-cli/assets"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo/mocksysteminfo"
+	"github.com/stretchr/testify/suite"
+)
+
+type templateActionSuite struct {
+	suite.Suite
+	dir     string
+	mockSys *mocksysteminfo.MockClient
+}
+
+func (s *templateActionSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *templateActionSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+	s.mockSys = mocksysteminfo.NewMockClient(s.T())
+	s.mockSys.EXPECT().CurrentPlatform().Return("linux").Maybe()
+}
+
+func (s *templateActionSuite) newAction(args *TemplateArgs) *TemplateAction {
+	fs := &workDirFileSystem{Client: filesystem.New(), dir: s.dir, homeDir: "/home/gum"}
+	username := func() (string, error) {
+		return "gum", nil
+	}
+
+	return newTemplateActionWithComponents("gum.yml up[0]", args, fs, s.mockSys, assets.GetAsset, username)
+}
+
+func (s *templateActionSuite) writeFile(path, content string) {
+	s.Require().NoError(os.MkdirAll(filepath.Dir(path), 0755))
+	s.Require().NoError(os.WriteFile(path, []byte(content), 0644))
+}
+
+func (s *templateActionSuite) readFile(path string) string {
+	content, err := os.ReadFile(path)
+	s.Require().NoError(err)
+
+	return string(content)
+}
+
+func (s *templateActionSuite) TestValidate() {
+	s.Require().EqualError(s.newAction(&TemplateArgs{Output: "config/database.yml"}).Validate(), "gum.yml up[0]: templates require either a source or an embedded template")
+	s.Require().EqualError(s.newAction(&TemplateArgs{Source: "config/database.yml.tmpl"}).Validate(), "gum.yml up[0]: template output is required")
+	s.Require().EqualError(s.newAction(&TemplateArgs{Embedded: "rails/secrets.yml", Output: "config/secrets.yml"}).Validate(), "gum.yml up[0]: gum has no rails/secrets.yml template")
+	s.Require().NoError(s.newAction(&TemplateArgs{Embedded: "rails/database.yml", Output: "config/database.yml"}).Validate())
+}
+
+func (s *templateActionSuite) TestRunWritesAndUpdates() {
+	s.writeFile(filepath.Join(s.dir, "config", "app.yml.tmpl"), "user: {{ .User }}\nos: {{ .OS }}\ngum: {{ .GumHome }}\nport: {{ .Vars.port }}\n")
+	args := &TemplateArgs{Source: "config/app.yml.tmpl", Output: "config/local/app.yml", Vars: map[string]interface{}{"port": 3000}}
+	action := s.newAction(args)
+
+	s.Require().True(action.ShouldRun())
+	s.Require().NoError(action.Run())
+	s.Require().Equal("user: gum\nos: linux\ngum: /home/gum/.gum\nport: 3000\n", s.readFile(filepath.Join(s.dir, "config", "local", "app.yml")))
+	s.Require().False(action.ShouldRun())
+
+	args.Vars["port"] = 3001
+	s.Require().True(action.ShouldRun())
+	s.Require().NoError(action.Run())
+	s.Require().Equal("user: gum\nos: linux\ngum: /home/gum/.gum\nport: 3001\n", s.readFile(filepath.Join(s.dir, "config", "local", "app.yml")))
+}
+
+func (s *templateActionSuite) TestRunMissingVar() {
+	s.writeFile(filepath.Join(s.dir, "app.yml.tmpl"), "port: {{ .Vars.port }}\n")
+
+	err := s.newAction(&TemplateArgs{Source: "app.yml.tmpl", Output: "app.yml"}).Run()
+
+	s.Require().ErrorContains(err, `gum.yml up[0]: Failed to render template app.yml.tmpl`)
+	s.Require().NoFileExists(filepath.Join(s.dir, "app.yml"))
+}
+
+func (s *templateActionSuite) TestRunEmbedded() {
+	s.T().Setenv("DATABASE_HOST", "db.local")
+	action := s.newAction(&TemplateArgs{
+		Embedded: "rails/database.yml",
+		Output:   "config/database.yml",
+		Vars:     map[string]interface{}{"adapter": "postgresql", "port": 5432, "database": "gumroad"},
+	})
+
+	s.Require().NoError(action.Run())
+
+	content := s.readFile(filepath.Join(s.dir, "config", "database.yml"))
+	s.Require().Contains(content, "  adapter: postgresql\n  encoding: unicode\n  username: gum\n  host: db.local\n  port: 5432\n")
+	s.Require().Contains(content, "database: gumroad_development\n")
+}
+
+func TestTemplateActionSuite(t *testing.T) {
+	suite.Run(t, new(templateActionSuite))
+}
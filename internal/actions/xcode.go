@@ -2,36 +2,61 @@ package actions
 
 import (
 	"slices"
+	"time"
 
 	"github.com/pkg/errors"
-	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
 	"github.com/renegumroad/gum-cli/internal/cli/xcode"
 	"github.com/renegumroad/gum-cli/internal/systeminfo"
 )
 
+const defaultXcodeTimeout = 30 * time.Minute
+
+var xcodeSchema = Schema{
+	{
+		Name:        "min_version",
+		Type:        StringParam,
+		Description: "Minimum version of the Command Line Tools, or of Xcode when it is the active developer directory.",
+	},
+	{
+		Name:        "timeout",
+		Type:        DurationParam,
+		Description: "How long to wait for the Command Line Tools installer. Defaults to 30m.",
+	},
+}
+
+// XcodeArgs configures the xcode action.
+type XcodeArgs struct {
+	MinVersion string        `yaml:"min_version"`
+	Timeout    time.Duration `yaml:"timeout"`
+}
+
 type XcodeAction struct {
-	cmdGen cmdexec.CmdGenerator
-	sys    systeminfo.Client
-	xcode  xcode.Client
+	args  XcodeArgs
+	sys   systeminfo.Client
+	xcode xcode.Client
 }
 
-func NewXcodeAction() *XcodeAction {
+func NewXcodeAction(args XcodeArgs) *XcodeAction {
 	return newXcodeActionWithComponents(
-		cmdexec.NewCommandGenerator(),
+		args,
 		systeminfo.New(),
 		xcode.New(),
 	)
 }
 
 func newXcodeActionWithComponents(
-	gen cmdexec.CmdGenerator,
+	args XcodeArgs,
 	sys systeminfo.Client,
 	xcode xcode.Client,
 ) *XcodeAction {
+	if args.Timeout == 0 {
+		args.Timeout = defaultXcodeTimeout
+	}
+
 	return &XcodeAction{
-		cmdGen: gen,
-		sys:    sys,
-		xcode:  xcode,
+		args:  args,
+		sys:   sys,
+		xcode: xcode,
 	}
 }
 
@@ -40,11 +65,15 @@ func (a *XcodeAction) Name() string {
 }
 
 func (a *XcodeAction) Identifier() string {
+	if a.args.MinVersion != "" {
+		return "xcode-" + a.args.MinVersion
+	}
+
 	return "xcode"
 }
 
 func (a *XcodeAction) IsPublic() bool {
-	return false
+	return true
 }
 
 func (a *XcodeAction) Deps() []Action {
@@ -64,9 +93,41 @@ func (a *XcodeAction) Validate() error {
 }
 
 func (a *XcodeAction) ShouldRun() bool {
-	return !a.xcode.IsInstalled()
+	if !a.xcode.IsInstalled() {
+		return true
+	}
+
+	return a.check() != nil
 }
 
 func (a *XcodeAction) Run() error {
-	return a.xcode.EnsureInstalled()
+	if err := a.xcode.EnsureInstalled(a.args.Timeout); err != nil {
+		return err
+	}
+
+	return a.check()
+}
+
+// check verifies the minimum version and the license of the installed
+// tools. Neither can be fixed by gum, so they are reported as errors.
+func (a *XcodeAction) check() error {
+	info, err := a.xcode.Info()
+	if err != nil {
+		return err
+	}
+
+	name := "Command Line Tools"
+	if info.FullXcode {
+		name = "Xcode"
+	}
+
+	if a.args.MinVersion != "" && xcode.CompareVersions(info.Version, a.args.MinVersion) < 0 {
+		return errors.Errorf("%s %s in %s is older than the required %s. Update it with softwareupdate or the App Store", name, info.Version, info.Path, a.args.MinVersion)
+	}
+
+	if info.FullXcode && !a.xcode.LicenseAccepted() {
+		return errors.Errorf("The Xcode license has not been accepted. Run sudo xcodebuild -license accept")
+	}
+
+	return nil
 }
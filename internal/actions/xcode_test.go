@@ -0,0 +1,81 @@
+package actions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/renegumroad/gum-cli/internal/cli/xcode"
+	"github.com/renegumroad/gum-cli/internal/cli/xcode/mockxcode"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo/mocksysteminfo"
+	"github.com/stretchr/testify/suite"
+)
+
+type xcodeActionSuite struct {
+	suite.Suite
+	mockSys   *mocksysteminfo.MockClient
+	mockXcode *mockxcode.MockClient
+}
+
+func (s *xcodeActionSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *xcodeActionSuite) SetupTest() {
+	s.mockSys = mocksysteminfo.NewMockClient(s.T())
+	s.mockXcode = mockxcode.NewMockClient(s.T())
+}
+
+func (s *xcodeActionSuite) newAction(args XcodeArgs) *XcodeAction {
+	return newXcodeActionWithComponents(args, s.mockSys, s.mockXcode)
+}
+
+func (s *xcodeActionSuite) TestShouldRunNotInstalled() {
+	s.mockXcode.EXPECT().IsInstalled().Return(false)
+
+	s.Require().True(s.newAction(XcodeArgs{}).ShouldRun())
+}
+
+func (s *xcodeActionSuite) TestShouldRunUpToDate() {
+	s.mockXcode.EXPECT().IsInstalled().Return(true)
+	s.mockXcode.EXPECT().Info().Return(&xcode.Info{Path: "/Library/Developer/CommandLineTools", Version: "15.3.0.0.1"}, nil)
+
+	s.Require().False(s.newAction(XcodeArgs{MinVersion: "15"}).ShouldRun())
+}
+
+func (s *xcodeActionSuite) TestRunWaitsForInstallation() {
+	s.mockXcode.EXPECT().EnsureInstalled(defaultXcodeTimeout).Return(nil)
+	s.mockXcode.EXPECT().Info().Return(&xcode.Info{Path: "/Library/Developer/CommandLineTools", Version: "15.3"}, nil)
+
+	s.Require().NoError(s.newAction(XcodeArgs{}).Run())
+}
+
+func (s *xcodeActionSuite) TestRunReportsOldVersion() {
+	s.mockXcode.EXPECT().EnsureInstalled(10 * time.Minute).Return(nil)
+	s.mockXcode.EXPECT().Info().Return(&xcode.Info{Path: "/Library/Developer/CommandLineTools", Version: "14.3.1"}, nil)
+
+	err := s.newAction(XcodeArgs{MinVersion: "15.0", Timeout: 10 * time.Minute}).Run()
+
+	s.Require().ErrorContains(err, "Command Line Tools 14.3.1 in /Library/Developer/CommandLineTools is older than the required 15.0")
+}
+
+func (s *xcodeActionSuite) TestRunReportsUnacceptedLicense() {
+	s.mockXcode.EXPECT().EnsureInstalled(defaultXcodeTimeout).Return(nil)
+	s.mockXcode.EXPECT().Info().Return(&xcode.Info{Path: "/Applications/Xcode.app/Contents/Developer", FullXcode: true, Version: "15.4"}, nil)
+	s.mockXcode.EXPECT().LicenseAccepted().Return(false)
+
+	s.Require().ErrorContains(s.newAction(XcodeArgs{}).Run(), "sudo xcodebuild -license accept")
+}
+
+func (s *xcodeActionSuite) TestNamedActionDecodesTimeout() {
+	act, err := NewNamedAction("xcode", map[string]interface{}{"min_version": "15", "timeout": "45m"})
+
+	s.Require().NoError(err)
+	s.Require().Equal(XcodeArgs{MinVersion: "15", Timeout: 45 * time.Minute}, act.(*XcodeAction).args)
+	s.Require().Equal("xcode-15", act.Identifier())
+}
+
+func TestXcodeActionSuite(t *testing.T) {
+	suite.Run(t, new(xcodeActionSuite))
+}
@@ -0,0 +1,285 @@
+package certs
+
+import (
+	"crypto"
+	"crypto/x509"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+)
+
+// renewBefore is how long before their expiry certificates are reissued.
+const renewBefore = 30 * 24 * time.Hour
+
+// Client manages the local certificate authority in ~/.gum/ca and the
+// development certificates it issues.
+type Client interface {
+	CACertPath() (string, error)
+	CheckCA() error
+	EnsureCA() error
+	CheckCertificate(certPath string, hosts []string) error
+	IssueCertificate(hosts []string, certPath, keyPath string) error
+	EnsureCertificate(hosts []string, certPath, keyPath string) ([]string, error)
+	IsTrusted() bool
+	TrustCommands() ([]string, error)
+	Trust() error
+}
+
+type client struct {
+	fs     filesystem.Client
+	sys    systeminfo.Client
+	cmdGen cmdexec.CmdGenerator
+	now    func() time.Time
+}
+
+func New() Client {
+	return newClientWithComponents(filesystem.New(), systeminfo.New(), cmdexec.NewCommandGenerator(), time.Now)
+}
+
+func newClientWithComponents(fs filesystem.Client, sys systeminfo.Client, gen cmdexec.CmdGenerator, now func() time.Time) *client {
+	return &client{
+		fs:     fs,
+		sys:    sys,
+		cmdGen: gen,
+		now:    now,
+	}
+}
+
+func (c *client) caDir() (string, error) {
+	homeDir, err := c.fs.HomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".gum", "ca"), nil
+}
+
+func (c *client) CACertPath() (string, error) {
+	dir, err := c.caDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "ca.pem"), nil
+}
+
+func (c *client) caKeyPath() (string, error) {
+	dir, err := c.caDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "ca-key.pem"), nil
+}
+
+// CheckCA returns why the CA has to be created again, or nil when it is
+// usable.
+func (c *client) CheckCA() error {
+	_, _, err := c.loadCA()
+	return err
+}
+
+// EnsureCA creates the CA when it is missing or about to expire. A new CA
+// has to be trusted again and invalidates the certificates of the old one.
+func (c *client) EnsureCA() error {
+	err := c.CheckCA()
+	if err == nil {
+		return nil
+	}
+	log.Debugf("Creating a new CA: %s", err)
+
+	dir, err := c.caDir()
+	if err != nil {
+		return err
+	}
+
+	certPath, err := c.CACertPath()
+	if err != nil {
+		return err
+	}
+
+	keyPath, err := c.caKeyPath()
+	if err != nil {
+		return err
+	}
+
+	certPEM, keyPEM, err := generateCA(c.now())
+	if err != nil {
+		return err
+	}
+
+	if err := c.fs.MkdirAll(dir); err != nil {
+		return err
+	}
+
+	if err := c.fs.WritePrivateString(keyPath, keyPEM); err != nil {
+		return err
+	}
+
+	if err := c.fs.WriteString(certPath, certPEM); err != nil {
+		return err
+	}
+
+	log.Infof("Created the local certificate authority in %s", dir)
+
+	return nil
+}
+
+func (c *client) loadCA() (*x509.Certificate, crypto.Signer, error) {
+	certPath, err := c.CACertPath()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyPath, err := c.caKeyPath()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !c.fs.Exists(certPath) || !c.fs.Exists(keyPath) {
+		return nil, nil, errors.Errorf("The local CA does not exist")
+	}
+
+	cert, err := c.readCertificate(certPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := c.checkExpiry(cert, certPath); err != nil {
+		return nil, nil, err
+	}
+
+	keyContent, err := c.fs.ReadString(keyPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key, err := parsePrivateKey(keyContent)
+	if err != nil {
+		return nil, nil, errors.Errorf("Invalid CA key %s: %s", keyPath, err)
+	}
+
+	return cert, key, nil
+}
+
+// CheckCertificate returns why the certificate has to be issued again, or
+// nil when it is signed by the CA, valid long enough and made for hosts.
+func (c *client) CheckCertificate(certPath string, hosts []string) error {
+	if !c.fs.Exists(certPath) {
+		return errors.Errorf("Certificate %s does not exist", certPath)
+	}
+
+	cert, err := c.readCertificate(certPath)
+	if err != nil {
+		return err
+	}
+
+	if err := c.checkExpiry(cert, certPath); err != nil {
+		return err
+	}
+
+	if !coversHosts(cert, hosts) {
+		return errors.Errorf("Certificate %s was issued for %s, not %s", certPath, strings.Join(cert.DNSNames, ", "), strings.Join(hosts, ", "))
+	}
+
+	ca, _, err := c.loadCA()
+	if err != nil {
+		return err
+	}
+
+	if err := cert.CheckSignatureFrom(ca); err != nil {
+		return errors.Errorf("Certificate %s is not signed by the local CA", certPath)
+	}
+
+	return nil
+}
+
+// IssueCertificate writes a certificate and key for hosts signed by the CA.
+func (c *client) IssueCertificate(hosts []string, certPath, keyPath string) error {
+	if len(hosts) == 0 {
+		return errors.Errorf("A certificate requires at least one host")
+	}
+
+	ca, key, err := c.loadCA()
+	if err != nil {
+		return err
+	}
+
+	certPEM, keyPEM, err := generateLeaf(ca, key, hosts, c.now())
+	if err != nil {
+		return err
+	}
+
+	for _, path := range []string{certPath, keyPath} {
+		if err := c.fs.MkdirAll(filepath.Dir(path)); err != nil {
+			return err
+		}
+	}
+
+	if err := c.fs.WritePrivateString(keyPath, keyPEM); err != nil {
+		return err
+	}
+
+	if err := c.fs.WriteString(certPath, certPEM); err != nil {
+		return err
+	}
+
+	log.Infof("Issued a certificate for %s in %s", strings.Join(hosts, ", "), certPath)
+
+	return nil
+}
+
+// EnsureCertificate sets up the CA and issues the certificate of hosts unless
+// it is up to date. Without hosts, only the CA is set up. It returns the
+// commands trusting the CA, or none when the system already trusts it.
+func (c *client) EnsureCertificate(hosts []string, certPath, keyPath string) ([]string, error) {
+	if err := c.EnsureCA(); err != nil {
+		return nil, err
+	}
+
+	if len(hosts) > 0 {
+		if err := c.CheckCertificate(certPath, hosts); err == nil {
+			log.Infof("Certificate %s for %s is up to date", certPath, strings.Join(hosts, ", "))
+		} else {
+			log.Debugf("Issuing a certificate: %s", err)
+
+			if err := c.IssueCertificate(hosts, certPath, keyPath); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if c.IsTrusted() {
+		return nil, nil
+	}
+
+	return c.TrustCommands()
+}
+
+func (c *client) readCertificate(path string) (*x509.Certificate, error) {
+	content, err := c.fs.ReadString(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := parseCertificate(content)
+	if err != nil {
+		return nil, errors.Errorf("Invalid certificate %s: %s", path, err)
+	}
+
+	return cert, nil
+}
+
+func (c *client) checkExpiry(cert *x509.Certificate, path string) error {
+	if c.now().Add(renewBefore).After(cert.NotAfter) {
+		return errors.Errorf("Certificate %s expires on %s", path, cert.NotAfter.Format(time.DateOnly))
+	}
+
+	return nil
+}
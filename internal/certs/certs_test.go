@@ -0,0 +1,185 @@
+package certs
+
+import (
+	"crypto/x509"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec/fakecmdexec"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+	"github.com/renegumroad/gum-cli/internal/systeminfo/mocksysteminfo"
+	"github.com/stretchr/testify/suite"
+)
+
+var certsTestNow = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+type certsSuite struct {
+	suite.Suite
+	homeDir string
+	now     time.Time
+	mockSys *mocksysteminfo.MockClient
+}
+
+type fakeFileSystem struct {
+	filesystem.Client
+	homeDir string
+}
+
+func (f *fakeFileSystem) HomeDir() (string, error) {
+	return f.homeDir, nil
+}
+
+func (s *certsSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *certsSuite) SetupTest() {
+	dir, err := filesystem.New().MkdirTemp()
+	s.Require().NoError(err)
+	s.homeDir = dir
+	s.now = certsTestNow
+	s.mockSys = mocksysteminfo.NewMockClient(s.T())
+}
+
+func (s *certsSuite) TearDownTest() {
+	os.RemoveAll(s.homeDir)
+}
+
+func (s *certsSuite) newClient(cmds ...fakecmdexec.SettableCommand) *client {
+	fs := &fakeFileSystem{Client: filesystem.New(), homeDir: s.homeDir}
+
+	return newClientWithComponents(fs, s.mockSys, fakecmdexec.NewCmdGenerator(cmds...), func() time.Time { return s.now })
+}
+
+func (s *certsSuite) read(path string) string {
+	content, err := os.ReadFile(path)
+	s.Require().NoError(err)
+
+	return string(content)
+}
+
+func (s *certsSuite) TestEnsureCA() {
+	client := s.newClient()
+	s.Require().ErrorContains(client.CheckCA(), "The local CA does not exist")
+
+	s.Require().NoError(client.EnsureCA())
+	s.Require().NoError(client.CheckCA())
+
+	info, err := os.Stat(filepath.Join(s.homeDir, ".gum", "ca", "ca-key.pem"))
+	s.Require().NoError(err)
+	s.Require().Equal(os.FileMode(0600), info.Mode().Perm())
+
+	certPath := filepath.Join(s.homeDir, ".gum", "ca", "ca.pem")
+	ca, err := parseCertificate(s.read(certPath))
+	s.Require().NoError(err)
+	s.Require().True(ca.IsCA)
+
+	// an existing CA is kept
+	s.Require().NoError(client.EnsureCA())
+	again, err := parseCertificate(s.read(certPath))
+	s.Require().NoError(err)
+	s.Require().Equal(ca.SerialNumber, again.SerialNumber)
+}
+
+func (s *certsSuite) TestIssueCertificate() {
+	client := s.newClient()
+	s.Require().NoError(client.EnsureCA())
+
+	certPath := filepath.Join(s.homeDir, "app", "certs", "app.gumroad.dev.pem")
+	keyPath := filepath.Join(s.homeDir, "app", "certs", "app.gumroad.dev-key.pem")
+	hosts := []string{"app.gumroad.dev", "*.gumroad.dev", "127.0.0.1"}
+
+	s.Require().NoError(client.IssueCertificate(hosts, certPath, keyPath))
+	s.Require().NoError(client.CheckCertificate(certPath, []string{"127.0.0.1", "*.gumroad.dev", "app.gumroad.dev"}))
+
+	ca, err := parseCertificate(s.read(filepath.Join(s.homeDir, ".gum", "ca", "ca.pem")))
+	s.Require().NoError(err)
+	leaf, err := parseCertificate(s.read(certPath))
+	s.Require().NoError(err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	_, err = leaf.Verify(x509.VerifyOptions{DNSName: "assets.gumroad.dev", Roots: roots, CurrentTime: s.now})
+	s.Require().NoError(err)
+
+	_, err = parsePrivateKey(s.read(keyPath))
+	s.Require().NoError(err)
+}
+
+func (s *certsSuite) TestCheckCertificateNeedsReissue() {
+	client := s.newClient()
+	s.Require().NoError(client.EnsureCA())
+
+	certPath := filepath.Join(s.homeDir, "app.pem")
+	s.Require().NoError(client.IssueCertificate([]string{"app.gumroad.dev"}, certPath, filepath.Join(s.homeDir, "app-key.pem")))
+
+	s.Require().ErrorContains(client.CheckCertificate(certPath, []string{"app.gumroad.dev", "api.gumroad.dev"}), "was issued for app.gumroad.dev")
+
+	s.now = certsTestNow.Add(800 * 24 * time.Hour)
+	s.Require().ErrorContains(client.CheckCertificate(certPath, []string{"app.gumroad.dev"}), "expires on 2026-09-04")
+
+	s.now = certsTestNow
+	s.Require().NoError(os.Remove(filepath.Join(s.homeDir, ".gum", "ca", "ca.pem")))
+	s.Require().NoError(client.EnsureCA())
+	s.Require().ErrorContains(client.CheckCertificate(certPath, []string{"app.gumroad.dev"}), "is not signed by the local CA")
+}
+
+func (s *certsSuite) TestEnsureCertificate() {
+	s.mockSys.EXPECT().IsMacOS().Return(true)
+	untrusted := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{Err: errors.New("exit status 1")})
+	trusted := fakecmdexec.NewNoOpCommand()
+	client := s.newClient(untrusted, trusted)
+
+	certPath := filepath.Join(s.homeDir, "app.pem")
+	keyPath := filepath.Join(s.homeDir, "app-key.pem")
+	hosts := []string{"app.gumroad.dev"}
+
+	commands, err := client.EnsureCertificate(hosts, certPath, keyPath)
+
+	s.Require().NoError(err)
+	s.Require().Equal([]string{
+		"sudo security add-trusted-cert -d -r trustRoot -k /Library/Keychains/System.keychain " + filepath.Join(s.homeDir, ".gum", "ca", "ca.pem"),
+	}, commands)
+	s.Require().NoError(client.CheckCertificate(certPath, hosts))
+	issued := s.read(certPath)
+
+	commands, err = client.EnsureCertificate(hosts, certPath, keyPath)
+
+	s.Require().NoError(err)
+	s.Require().Empty(commands)
+	s.Require().Equal(issued, s.read(certPath))
+}
+
+func (s *certsSuite) TestTrustCommandsOnDebian() {
+	s.mockSys.EXPECT().IsMacOS().Return(false)
+	s.mockSys.EXPECT().Distro().Return(&systeminfo.Distro{ID: "ubuntu", IDLike: []string{"debian"}}, nil)
+
+	commands, err := s.newClient().TrustCommands()
+
+	s.Require().NoError(err)
+	s.Require().Equal([]string{
+		"sudo install -m 0644 " + filepath.Join(s.homeDir, ".gum", "ca", "ca.pem") + " /usr/local/share/ca-certificates/gum-development-ca.crt",
+		"sudo update-ca-certificates",
+	}, commands)
+}
+
+func (s *certsSuite) TestIsTrustedOnMacOS() {
+	s.mockSys.EXPECT().IsMacOS().Return(true)
+	verifyCmd := fakecmdexec.NewNoOpCommand()
+	client := s.newClient(verifyCmd)
+	s.Require().NoError(client.EnsureCA())
+
+	s.Require().True(client.IsTrusted())
+	s.Require().Equal("security", verifyCmd.Cmd())
+	s.Require().Equal([]string{"verify-cert", "-c", filepath.Join(s.homeDir, ".gum", "ca", "ca.pem")}, verifyCmd.Args())
+}
+
+func TestCertsSuite(t *testing.T) {
+	suite.Run(t, new(certsSuite))
+}
@@ -0,0 +1,122 @@
+package certs
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/log"
+)
+
+const trustAnchorName = "gum-development-ca"
+
+// linuxTrustStore is where a distribution looks for additional CAs and the
+// command refreshing its bundle.
+type linuxTrustStore struct {
+	anchor string
+	update string
+}
+
+// IsTrusted reports whether the CA is installed in the trust store of the
+// system.
+func (c *client) IsTrusted() bool {
+	certPath, err := c.CACertPath()
+	if err != nil || !c.fs.Exists(certPath) {
+		return false
+	}
+
+	if c.sys.IsMacOS() {
+		cmd := c.cmdGen("security", "verify-cert", "-c", certPath)
+		if err := cmd.Run(); err != nil {
+			log.Debugf("The local CA is not trusted: %s %s", err, cmd.Stderr())
+			return false
+		}
+
+		return true
+	}
+
+	store, err := c.linuxTrustStore()
+	if err != nil {
+		log.Debugf("%s", err)
+		return false
+	}
+
+	if !c.fs.Exists(store.anchor) {
+		return false
+	}
+
+	equal, err := c.fs.EqualFiles(certPath, store.anchor)
+	return err == nil && equal
+}
+
+// TrustCommands returns the commands installing the CA in the trust store of
+// the system. They require sudo.
+func (c *client) TrustCommands() ([]string, error) {
+	certPath, err := c.CACertPath()
+	if err != nil {
+		return nil, err
+	}
+
+	if c.sys.IsMacOS() {
+		return []string{
+			fmt.Sprintf("sudo security add-trusted-cert -d -r trustRoot -k /Library/Keychains/System.keychain %s", certPath),
+		}, nil
+	}
+
+	store, err := c.linuxTrustStore()
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{
+		fmt.Sprintf("sudo install -m 0644 %s %s", certPath, store.anchor),
+		fmt.Sprintf("sudo %s", store.update),
+	}, nil
+}
+
+// Trust runs the trust commands, asking for the sudo password if needed.
+func (c *client) Trust() error {
+	commands, err := c.TrustCommands()
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Adding the local CA to the system trust store")
+
+	for _, command := range commands {
+		cmd := c.cmdGen("bash", "-c", command)
+		if err := cmd.Run(); err != nil {
+			return errors.Errorf("Failed %s: %s %s", command, err, cmd.Stderr())
+		}
+	}
+
+	return nil
+}
+
+func (c *client) linuxTrustStore() (*linuxTrustStore, error) {
+	distro, err := c.sys.Distro()
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case distro.Is("debian", "ubuntu"):
+		return &linuxTrustStore{
+			anchor: filepath.Join("/usr/local/share/ca-certificates", trustAnchorName+".crt"),
+			update: "update-ca-certificates",
+		}, nil
+	case distro.Is("fedora", "rhel", "centos"):
+		return &linuxTrustStore{
+			anchor: filepath.Join("/etc/pki/ca-trust/source/anchors", trustAnchorName+".pem"),
+			update: "update-ca-trust",
+		}, nil
+	case distro.Is("arch"):
+		return &linuxTrustStore{
+			anchor: filepath.Join("/etc/ca-certificates/trust-source/anchors", trustAnchorName+".crt"),
+			update: "update-ca-trust",
+		}, nil
+	}
+
+	return nil, errors.Errorf("Unsupported distribution %s for the trust store. Supported: %s", distro.ID, strings.Join([]string{"debian", "ubuntu", "fedora", "rhel", "arch"}, ", "))
+}
@@ -0,0 +1,170 @@
+package certs
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	caValidity = 10 * 365 * 24 * time.Hour
+	// browsers reject certificates valid for more than 825 days
+	leafValidity = 825 * 24 * time.Hour
+)
+
+// generateCA creates a self-signed certificate authority, returning its
+// certificate and private key in PEM.
+func generateCA(now time.Time) (string, string, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", errors.Errorf("Failed to generate the CA key: %s", err)
+	}
+
+	serial, err := serialNumber()
+	if err != nil {
+		return "", "", err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			Organization: []string{"gum development CA"},
+			CommonName:   "gum development CA",
+		},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return "", "", errors.Errorf("Failed to create the CA certificate: %s", err)
+	}
+
+	return encode(der, key)
+}
+
+// generateLeaf issues a server certificate for hosts, which may be DNS
+// names, wildcards or IP addresses, signed by the CA.
+func generateLeaf(ca *x509.Certificate, caKey crypto.Signer, hosts []string, now time.Time) (string, string, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", errors.Errorf("Failed to generate the certificate key: %s", err)
+	}
+
+	serial, err := serialNumber()
+	if err != nil {
+		return "", "", err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			Organization: []string{"gum development certificate"},
+			CommonName:   hosts[0],
+		},
+		NotBefore:   now.Add(-time.Hour),
+		NotAfter:    now.Add(leafValidity),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return "", "", errors.Errorf("Failed to create the certificate: %s", err)
+	}
+
+	return encode(der, key)
+}
+
+// coversHosts reports whether the certificate was issued for exactly the
+// hosts, in any order.
+func coversHosts(cert *x509.Certificate, hosts []string) bool {
+	names := slices.Clone(cert.DNSNames)
+	for _, ip := range cert.IPAddresses {
+		names = append(names, ip.String())
+	}
+
+	expected := []string{}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			host = ip.String()
+		}
+		expected = append(expected, host)
+	}
+
+	slices.Sort(names)
+	slices.Sort(expected)
+
+	return slices.Equal(names, slices.Compact(expected))
+}
+
+func parseCertificate(content string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(content))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.Errorf("No PEM certificate found")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func parsePrivateKey(content string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(content))
+	if block == nil || block.Type != "PRIVATE KEY" {
+		return nil, errors.Errorf("No PEM private key found")
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.Errorf("Unsupported private key type %T", key)
+	}
+
+	return signer, nil
+}
+
+func encode(der []byte, key *ecdsa.PrivateKey) (string, string, error) {
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", "", errors.Errorf("Failed to encode the private key: %s", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer})
+
+	return string(certPEM), string(keyPEM), nil
+}
+
+func serialNumber() (*big.Int, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Errorf("Failed to generate a serial number: %s", err)
+	}
+
+	return serial, nil
+}
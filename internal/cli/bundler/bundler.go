@@ -13,14 +13,15 @@ import (
 )
 
 type Client interface {
+	HasGemfile() bool
 	InstallGems() error
+	IsBundleSatisfied() bool
 	InstallBundler() error
 	IsBundlerInstalled() bool
 	EnsureBundlerInstalled() error
 }
 
 type client struct {
-	dir    string
 	fs     filesystem.Client
 	cmdGen cmdexec.CmdGenerator
 }
@@ -42,16 +43,24 @@ func newClientWithComponents(
 	}
 }
 
-func (c *client) InstallGems() error {
-	log.Debugf("Installing gems with Bundler")
+// HasGemfile reports whether the current directory has a Gemfile. Ruby
+// projects without gems don't need one.
+func (c *client) HasGemfile() bool {
 	dir, err := c.fs.CurrentDir()
 	if err != nil {
-		return err
+		log.Debugf("Unable to check for Gemfile: %s", err)
+		return false
 	}
-	gemfile := filepath.Join(dir, "Gemfile")
 
-	if !c.fs.Exists(gemfile) {
-		return errors.Errorf("Gemfile not found in %s", c.dir)
+	return c.fs.Exists(filepath.Join(dir, "Gemfile"))
+}
+
+func (c *client) InstallGems() error {
+	log.Debugf("Installing gems with Bundler")
+
+	if !c.HasGemfile() {
+		log.Infof("No Gemfile found, skipping bundle install")
+		return nil
 	}
 
 	log.Infof("Running bundle install")
@@ -64,6 +73,22 @@ func (c *client) InstallGems() error {
 	return nil
 }
 
+// IsBundleSatisfied runs `bundle check`, which succeeds when every gem in
+// the Gemfile is already installed.
+func (c *client) IsBundleSatisfied() bool {
+	if !c.HasGemfile() {
+		return true
+	}
+
+	cmd := c.cmdGen("bundle", "check")
+	if err := cmd.Run(); err != nil {
+		log.Debugf("bundle check failed: %s", err)
+		return false
+	}
+
+	return true
+}
+
 func (c *client) EnsureBundlerInstalled() error {
 	if c.IsBundlerInstalled() {
 		log.Infof("Bundler is already installed")
@@ -91,7 +116,7 @@ func (c *client) IsBundlerInstalled() bool {
 		return false
 	}
 
-	return strings.EqualFold(cmd.Stdout(), "true")
+	return strings.EqualFold(strings.TrimSpace(cmd.Stdout()), "true")
 }
 
 func (c *client) InstallBundler() error {
@@ -116,7 +141,7 @@ func (c *client) InstallBundler() error {
 func (c *client) getBundlerVersion() string {
 	version, err := c.getVersionFromVersionFile()
 
-	if err != nil {
+	if err != nil || version == "" {
 		version, _ = c.getVersionFromGemfileLock()
 	}
 
@@ -139,6 +164,7 @@ func (c *client) getVersionFromVersionFile() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	content = strings.TrimSpace(content)
 
 	if content == "" {
 		return "", errors.Errorf("Bundler version not found in .bundler-version file")
@@ -1,8 +1,10 @@
 package bundler
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec/fakecmdexec"
 	"github.com/renegumroad/gum-cli/internal/filesystem/mockfilesystem"
 	"github.com/renegumroad/gum-cli/internal/log"
 	"github.com/stretchr/testify/suite"
@@ -132,6 +134,81 @@ func (s *bundlerSuite) TestGetVersionFromGemfileLockExistsWithEmptyVersion() {
 	s.mockFs.AssertExpectations(s.T())
 }
 
+func (s *bundlerSuite) TestGetBundlerVersionFallsBackToGemfileLock() {
+	s.mockFs.On("CurrentDir").Return("/test/dir", nil)
+	s.mockFs.On("Exists", "/test/dir/.bundler-version").Return(false)
+	s.mockFs.On("Exists", "/test/dir/Gemfile.lock").Return(true)
+	s.mockFs.On("ReadString", "/test/dir/Gemfile.lock").Return("BUNDLED WITH\n   2.5.11\n", nil)
+
+	client := newClientWithComponents(s.mockFs, nil)
+
+	s.Equal("2.5.11", client.getBundlerVersion())
+}
+
+func (s *bundlerSuite) TestGetVersionFromVersionFileWithTrailingNewline() {
+	s.mockFs.On("CurrentDir").Return("/test/dir", nil)
+	s.mockFs.On("Exists", "/test/dir/.bundler-version").Return(true)
+	s.mockFs.On("ReadString", "/test/dir/.bundler-version").Return("2.5.11\n", nil)
+
+	client := newClientWithComponents(s.mockFs, nil)
+
+	version, err := client.getVersionFromVersionFile()
+
+	s.Require().NoError(err)
+	s.Equal("2.5.11", version)
+}
+
+func (s *bundlerSuite) TestIsBundlerInstalled() {
+	s.mockFs.On("CurrentDir").Return("/test/dir", nil)
+	s.mockFs.On("Exists", "/test/dir/.bundler-version").Return(true)
+	s.mockFs.On("ReadString", "/test/dir/.bundler-version").Return("2.5.11", nil)
+	gemCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: "true\n",
+	})
+
+	client := newClientWithComponents(s.mockFs, fakecmdexec.NewCmdGenerator(gemCmd))
+
+	s.Require().True(client.IsBundlerInstalled())
+	s.Require().Equal("gem", gemCmd.Cmd())
+	s.Require().Equal([]string{"list", "--installed", "--exact", "bundler", "--version", "2.5.11"}, gemCmd.Args())
+}
+
+func (s *bundlerSuite) TestInstallGemsWithoutGemfile() {
+	s.mockFs.On("CurrentDir").Return("/test/dir", nil)
+	s.mockFs.On("Exists", "/test/dir/Gemfile").Return(false)
+
+	client := newClientWithComponents(s.mockFs, fakecmdexec.NewCmdGenerator())
+
+	s.Require().NoError(client.InstallGems())
+	s.Require().True(client.IsBundleSatisfied())
+}
+
+func (s *bundlerSuite) TestIsBundleSatisfied() {
+	s.mockFs.On("CurrentDir").Return("/test/dir", nil)
+	s.mockFs.On("Exists", "/test/dir/Gemfile").Return(true)
+	checkCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: "The Gemfile's dependencies are satisfied",
+	})
+
+	client := newClientWithComponents(s.mockFs, fakecmdexec.NewCmdGenerator(checkCmd))
+
+	s.Require().True(client.IsBundleSatisfied())
+	s.Require().Equal("bundle", checkCmd.Cmd())
+	s.Require().Equal([]string{"check"}, checkCmd.Args())
+}
+
+func (s *bundlerSuite) TestIsBundleNotSatisfied() {
+	s.mockFs.On("CurrentDir").Return("/test/dir", nil)
+	s.mockFs.On("Exists", "/test/dir/Gemfile").Return(true)
+	checkCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Err: errors.New("exit status 1"),
+	})
+
+	client := newClientWithComponents(s.mockFs, fakecmdexec.NewCmdGenerator(checkCmd))
+
+	s.Require().False(client.IsBundleSatisfied())
+}
+
 func TestBundlerSuite(t *testing.T) {
 	suite.Run(t, new(bundlerSuite))
 }
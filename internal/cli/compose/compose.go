@@ -0,0 +1,149 @@
+package compose
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
+	"github.com/renegumroad/gum-cli/internal/log"
+)
+
+// Container is an entry of `docker compose ps --format json`. Health is empty
+// for services without a healthcheck.
+type Container struct {
+	Name     string `json:"Name"`
+	Service  string `json:"Service"`
+	State    string `json:"State"`
+	Health   string `json:"Health"`
+	ExitCode int    `json:"ExitCode"`
+}
+
+// IsReady reports whether the container is running and, when it declares a
+// healthcheck, healthy.
+func (c *Container) IsReady() bool {
+	return c.State == "running" && (c.Health == "" || c.Health == "healthy")
+}
+
+// IsCompleted reports whether the container exited successfully, as one-shot
+// services such as migrations do.
+func (c *Container) IsCompleted() bool {
+	return c.State == "exited" && c.ExitCode == 0
+}
+
+// Client runs docker compose for the compose file of a project. An empty
+// file lets docker compose find the file of the current directory.
+type Client interface {
+	IsAvailable() error
+	Services(file string) ([]string, error)
+	Ps(file string) ([]Container, error)
+	Up(file string, services []string) error
+}
+
+type client struct {
+	cmdGen cmdexec.CmdGenerator
+}
+
+func New() Client {
+	return newClientWithComponents(cmdexec.NewCommandGenerator())
+}
+
+func newClientWithComponents(gen cmdexec.CmdGenerator) *client {
+	return &client{
+		cmdGen: gen,
+	}
+}
+
+// IsAvailable checks that the compose plugin is installed and that a
+// container runtime answers.
+func (c *client) IsAvailable() error {
+	if _, err := c.run("docker", "compose", "version"); err != nil {
+		return errors.Errorf("docker compose is not installed: %s", err)
+	}
+
+	if _, err := c.run("docker", "info", "--format", "{{.ServerVersion}}"); err != nil {
+		return errors.Errorf("No container runtime is running. Start Docker Desktop, colima or OrbStack: %s", err)
+	}
+
+	return nil
+}
+
+func (c *client) Services(file string) ([]string, error) {
+	out, err := c.run("docker", c.args(file, "config", "--services")...)
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Fields(out), nil
+}
+
+func (c *client) Ps(file string) ([]Container, error) {
+	out, err := c.run("docker", c.args(file, "ps", "--all", "--format", "json")...)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsePs(out)
+}
+
+func (c *client) Up(file string, services []string) error {
+	if len(services) > 0 {
+		log.Infof("Starting compose services %s", strings.Join(services, ", "))
+	} else {
+		log.Infof("Starting compose services")
+	}
+
+	_, err := c.run("docker", c.args(file, append([]string{"up", "--detach"}, services...)...)...)
+	return err
+}
+
+func (c *client) args(file string, args ...string) []string {
+	base := []string{"compose"}
+	if file != "" {
+		base = append(base, "--file", file)
+	}
+
+	return append(base, args...)
+}
+
+// parsePs reads both output formats of docker compose ps: a JSON array up to
+// compose 2.20 and one JSON object per line since.
+func parsePs(out string) ([]Container, error) {
+	containers := []Container{}
+
+	out = strings.TrimSpace(out)
+	if out == "" {
+		return containers, nil
+	}
+
+	if strings.HasPrefix(out, "[") {
+		if err := json.Unmarshal([]byte(out), &containers); err != nil {
+			return nil, errors.Errorf("Unable to parse docker compose ps: %s", err)
+		}
+
+		return containers, nil
+	}
+
+	for _, line := range strings.Split(out, "\n") {
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+
+		container := Container{}
+		if err := json.Unmarshal([]byte(line), &container); err != nil {
+			return nil, errors.Errorf("Unable to parse docker compose ps: %s", err)
+		}
+		containers = append(containers, container)
+	}
+
+	return containers, nil
+}
+
+func (c *client) run(name string, args ...string) (string, error) {
+	cmd := c.cmdGen(name, args...)
+	if err := cmd.Run(); err != nil {
+		return "", errors.Errorf("Failed %s %s: %s %s", name, strings.Join(args, " "), err, cmd.Stderr())
+	}
+
+	return cmd.Stdout(), nil
+}
@@ -0,0 +1,87 @@
+package compose
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec/fakecmdexec"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type composeSuite struct {
+	suite.Suite
+}
+
+func (s *composeSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *composeSuite) TestPsLines() {
+	cmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: `{"Name":"app-mysql-1","Service":"mysql","State":"running","Health":"healthy"}
+{"Name":"app-redis-1","Service":"redis","State":"running","Health":""}
+{"Name":"app-elasticsearch-1","Service":"elasticsearch","State":"running","Health":"starting"}
+`,
+	})
+	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(cmd))
+
+	containers, err := client.Ps("docker-compose.dev.yml")
+
+	s.Require().NoError(err)
+	s.Require().Equal("docker", cmd.Cmd())
+	s.Require().Equal([]string{"compose", "--file", "docker-compose.dev.yml", "ps", "--all", "--format", "json"}, cmd.Args())
+	s.Require().Len(containers, 3)
+	s.Require().True(containers[0].IsReady())
+	s.Require().True(containers[1].IsReady())
+	s.Require().False(containers[2].IsReady())
+}
+
+func (s *composeSuite) TestPsArray() {
+	cmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: `[{"Name":"app-mysql-1","Service":"mysql","State":"exited","Health":"","ExitCode":137},{"Name":"app-migrate-1","Service":"migrate","State":"exited","Health":"","ExitCode":0}]`,
+	})
+	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(cmd))
+
+	containers, err := client.Ps("")
+
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"compose", "ps", "--all", "--format", "json"}, cmd.Args())
+	s.Require().Equal([]Container{
+		{Name: "app-mysql-1", Service: "mysql", State: "exited", ExitCode: 137},
+		{Name: "app-migrate-1", Service: "migrate", State: "exited"},
+	}, containers)
+	s.Require().False(containers[0].IsReady())
+	s.Require().False(containers[0].IsCompleted())
+	s.Require().True(containers[1].IsCompleted())
+}
+
+func (s *composeSuite) TestUp() {
+	cmd := fakecmdexec.NewNoOpCommand()
+	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(cmd))
+
+	err := client.Up("", []string{"mysql", "redis"})
+
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"compose", "up", "--detach", "mysql", "redis"}, cmd.Args())
+}
+
+func (s *composeSuite) TestIsAvailableWithoutRuntime() {
+	versionCmd := fakecmdexec.NewNoOpCommand()
+	infoCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Err:    errors.Errorf("exit status 1"),
+		Stderr: "Cannot connect to the Docker daemon at unix:///var/run/docker.sock",
+	})
+	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(versionCmd, infoCmd))
+
+	err := client.IsAvailable()
+
+	s.Require().ErrorContains(err, "No container runtime is running")
+	s.Require().Equal([]string{"compose", "version"}, versionCmd.Args())
+	s.Require().Equal([]string{"info", "--format", "{{.ServerVersion}}"}, infoCmd.Args())
+}
+
+func TestComposeSuite(t *testing.T) {
+	suite.Run(t, new(composeSuite))
+}
@@ -0,0 +1,112 @@
+package git
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
+)
+
+// Repository holds the git directories of a working tree. For a linked
+// worktree, GitDir is .git/worktrees/<name> of the main repository and
+// CommonDir its .git directory, which holds the hooks and the config.
+type Repository struct {
+	GitDir    string
+	CommonDir string
+}
+
+func (r *Repository) IsWorktree() bool {
+	return r.GitDir != r.CommonDir
+}
+
+// HooksDir is the default hooks directory, shared by every worktree.
+func (r *Repository) HooksDir() string {
+	return filepath.Join(r.CommonDir, "hooks")
+}
+
+// Client runs git in the working tree of dir.
+type Client interface {
+	Repository(dir string) (*Repository, error)
+	Config(dir, key string) (string, error)
+	SetConfig(dir, key, value string) error
+	Clone(url, dest string) error
+}
+
+type client struct {
+	cmdGen cmdexec.CmdGenerator
+}
+
+func New() Client {
+	return newClientWithComponents(cmdexec.NewCommandGenerator())
+}
+
+func newClientWithComponents(gen cmdexec.CmdGenerator) *client {
+	return &client{
+		cmdGen: gen,
+	}
+}
+
+func (c *client) Repository(dir string) (*Repository, error) {
+	out, err := c.run(dir, "rev-parse", "--git-dir", "--git-common-dir")
+	if err != nil {
+		return nil, errors.Errorf("%s is not a git repository: %s", dir, err)
+	}
+
+	lines := strings.Fields(out)
+	if len(lines) != 2 {
+		return nil, errors.Errorf("Unexpected git rev-parse output: %s", out)
+	}
+
+	return &Repository{
+		GitDir:    absolute(dir, lines[0]),
+		CommonDir: absolute(dir, lines[1]),
+	}, nil
+}
+
+// Config returns the value of a config key, or an empty string when it is
+// not set.
+func (c *client) Config(dir, key string) (string, error) {
+	cmd := c.cmdGen("git", "-C", dir, "config", "--get", key)
+	if err := cmd.Run(); err != nil {
+		// git config --get exits with 1 and no message for unset keys
+		if strings.TrimSpace(cmd.Stderr()) == "" {
+			return "", nil
+		}
+
+		return "", errors.Errorf("Failed git config --get %s: %s %s", key, err, cmd.Stderr())
+	}
+
+	return strings.TrimSpace(cmd.Stdout()), nil
+}
+
+func (c *client) SetConfig(dir, key, value string) error {
+	_, err := c.run(dir, "config", key, value)
+	return err
+}
+
+func (c *client) Clone(url, dest string) error {
+	cmd := c.cmdGen("git", "clone", url, dest)
+	if err := cmd.Run(); err != nil {
+		return errors.Errorf("Failed git clone %s: %s %s", url, err, strings.TrimSpace(cmd.Stderr()))
+	}
+
+	return nil
+}
+
+func (c *client) run(dir string, args ...string) (string, error) {
+	cmd := c.cmdGen("git", append([]string{"-C", dir}, args...)...)
+	if err := cmd.Run(); err != nil {
+		return "", errors.Errorf("Failed git %s: %s %s", strings.Join(args, " "), err, strings.TrimSpace(cmd.Stderr()))
+	}
+
+	return cmd.Stdout(), nil
+}
+
+func absolute(dir, path string) string {
+	if filepath.IsAbs(path) {
+		return filepath.Clean(path)
+	}
+
+	return filepath.Join(dir, path)
+}
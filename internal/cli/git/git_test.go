@@ -0,0 +1,61 @@
+package git
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec/fakecmdexec"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type gitSuite struct {
+	suite.Suite
+}
+
+func (s *gitSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *gitSuite) TestRepository() {
+	cmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{Stdout: ".git\n.git\n"})
+	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(cmd))
+
+	repo, err := client.Repository("/app")
+
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"-C", "/app", "rev-parse", "--git-dir", "--git-common-dir"}, cmd.Args())
+	s.Require().False(repo.IsWorktree())
+	s.Require().Equal("/app/.git/hooks", repo.HooksDir())
+}
+
+func (s *gitSuite) TestRepositoryWorktree() {
+	cmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{Stdout: "/src/app/.git/worktrees/feature\n/src/app/.git\n"})
+	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(cmd))
+
+	repo, err := client.Repository("/src/feature")
+
+	s.Require().NoError(err)
+	s.Require().True(repo.IsWorktree())
+	s.Require().Equal("/src/app/.git/hooks", repo.HooksDir())
+}
+
+func (s *gitSuite) TestConfig() {
+	unset := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{Err: errors.New("exit status 1")})
+	set := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{Stdout: ".githooks\n"})
+	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(unset, set))
+
+	value, err := client.Config("/app", "core.hooksPath")
+	s.Require().NoError(err)
+	s.Require().Equal("", value)
+
+	value, err = client.Config("/app", "core.hooksPath")
+	s.Require().NoError(err)
+	s.Require().Equal(".githooks", value)
+	s.Require().Equal([]string{"-C", "/app", "config", "--get", "core.hooksPath"}, set.Args())
+}
+
+func TestGitSuite(t *testing.T) {
+	suite.Run(t, new(gitSuite))
+}
@@ -0,0 +1,150 @@
+package golang
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
+	"github.com/renegumroad/gum-cli/internal/log"
+)
+
+// Client runs the go command to set up a Go project.
+type Client interface {
+	Version() (string, error)
+	HasToolchain(version string) bool
+	DownloadToolchain(version string) error
+	IsModCacheComplete() bool
+	ModDownload() error
+	BinDir() (string, error)
+	InstalledVersion(binary string) (string, error)
+	Install(pkg, version string) error
+}
+
+type client struct {
+	cmdGen cmdexec.EnvCmdGenerator
+}
+
+func New() Client {
+	return newClientWithComponents(cmdexec.NewEnvCommandGenerator())
+}
+
+func newClientWithComponents(gen cmdexec.EnvCmdGenerator) *client {
+	return &client{
+		cmdGen: gen,
+	}
+}
+
+// Version returns the version of the go command in the PATH, without the
+// "go" prefix. GOTOOLCHAIN=local prevents a toolchain switch.
+func (c *client) Version() (string, error) {
+	out, err := c.runGo([]string{"GOTOOLCHAIN=local"}, "env", "GOVERSION")
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimPrefix(strings.TrimSpace(out), "go"), nil
+}
+
+// HasToolchain reports whether the toolchain of a version is usable without
+// a download, because it is the local go or already in the module cache.
+func (c *client) HasToolchain(version string) bool {
+	toolchain := ToolchainName(version)
+	if _, err := c.runGo([]string{"GOTOOLCHAIN=" + toolchain, "GOPROXY=off"}, "env", "GOVERSION"); err != nil {
+		log.Debugf("Go toolchain %s is not downloaded: %s", toolchain, err)
+		return false
+	}
+
+	return true
+}
+
+// DownloadToolchain makes the go command fetch the toolchain of a version,
+// such as 1.23 or 1.23.4, into the module cache, where it is reused for
+// modules requiring it.
+func (c *client) DownloadToolchain(version string) error {
+	toolchain := ToolchainName(version)
+	log.Infof("Downloading Go toolchain %s", toolchain)
+
+	if _, err := c.runGo([]string{"GOTOOLCHAIN=" + toolchain}, "version"); err != nil {
+		return errors.Errorf("Failed to download Go toolchain %s: %s", version, err)
+	}
+
+	return nil
+}
+
+// IsModCacheComplete reports whether every module dependency is already in
+// the module cache, by running a download that is not allowed to fetch.
+func (c *client) IsModCacheComplete() bool {
+	_, err := c.runGo([]string{"GOPROXY=off", "GOFLAGS=-mod=mod"}, "mod", "download")
+	if err != nil {
+		log.Debugf("Module cache is incomplete: %s", err)
+		return false
+	}
+
+	return true
+}
+
+func (c *client) ModDownload() error {
+	log.Infof("Running go mod download")
+
+	_, err := c.runGo([]string{}, "mod", "download")
+	return err
+}
+
+// BinDir is where go install puts binaries: $GOBIN, or $GOPATH/bin.
+func (c *client) BinDir() (string, error) {
+	out, err := c.runGo([]string{}, "env", "GOBIN", "GOPATH")
+	if err != nil {
+		return "", err
+	}
+
+	lines := strings.Split(out, "\n")
+	if gobin := strings.TrimSpace(lines[0]); gobin != "" {
+		return gobin, nil
+	}
+
+	if len(lines) < 2 || strings.TrimSpace(lines[1]) == "" {
+		return "", errors.Errorf("Unable to find the go install directory")
+	}
+
+	// GOPATH can be a list, go install uses the first entry
+	gopath := filepath.SplitList(strings.TrimSpace(lines[1]))[0]
+
+	return filepath.Join(gopath, "bin"), nil
+}
+
+// InstalledVersion returns the version of the module a binary was built
+// from, as recorded in its build info.
+func (c *client) InstalledVersion(binary string) (string, error) {
+	out, err := c.runGo([]string{}, "version", "-m", binary)
+	if err != nil {
+		return "", err
+	}
+
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 3 && fields[0] == "mod" {
+			return fields[2], nil
+		}
+	}
+
+	return "", errors.Errorf("No module version found in %s", binary)
+}
+
+func (c *client) Install(pkg, version string) error {
+	target := fmt.Sprintf("%s@%s", pkg, version)
+	log.Infof("Running go install %s", target)
+
+	_, err := c.runGo([]string{}, "install", target)
+	return err
+}
+
+func (c *client) runGo(env []string, args ...string) (string, error) {
+	cmd := c.cmdGen("go", args, env)
+	if err := cmd.Run(); err != nil {
+		return "", errors.Errorf("Failed go %s: %s %s", strings.Join(args, " "), err, cmd.Stderr())
+	}
+
+	return cmd.Stdout(), nil
+}
@@ -0,0 +1,182 @@
+package golang
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec/fakecmdexec"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type golangSuite struct {
+	suite.Suite
+}
+
+func (s *golangSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+const testGoMod = `module github.com/acme/api
+
+go 1.22.1
+
+toolchain go1.22.4
+
+require github.com/spf13/cobra v1.8.1
+
+require (
+	golang.org/x/tools v0.20.0 // indirect
+	golang.org/x/tools/gopls v0.15.3
+)
+
+tool (
+	golang.org/x/tools/cmd/stringer
+	golang.org/x/tools/gopls
+)
+`
+
+func (s *golangSuite) TestParseGoMod() {
+	mod := ParseGoMod(testGoMod)
+
+	s.Require().Equal("github.com/acme/api", mod.Module)
+	s.Require().Equal("1.22.1", mod.Go)
+	s.Require().Equal("1.22.4", mod.Toolchain)
+	s.Require().Equal("1.22.4", mod.RequiredVersion())
+	s.Require().Equal(map[string]string{
+		"github.com/spf13/cobra":   "v1.8.1",
+		"golang.org/x/tools":       "v0.20.0",
+		"golang.org/x/tools/gopls": "v0.15.3",
+	}, mod.Requires)
+	s.Require().Equal([]string{"golang.org/x/tools/cmd/stringer", "golang.org/x/tools/gopls"}, mod.Tools)
+}
+
+func (s *golangSuite) TestModuleVersionUsesLongestModulePath() {
+	mod := ParseGoMod(testGoMod)
+
+	version, found := mod.ModuleVersion("golang.org/x/tools/gopls")
+	s.Require().True(found)
+	s.Require().Equal("v0.15.3", version)
+
+	version, found = mod.ModuleVersion("golang.org/x/tools/cmd/stringer")
+	s.Require().True(found)
+	s.Require().Equal("v0.20.0", version)
+
+	_, found = mod.ModuleVersion("github.com/golangci/golangci-lint/cmd/golangci-lint")
+	s.Require().False(found)
+}
+
+func (s *golangSuite) TestParseToolsFile() {
+	content := `//go:build tools
+
+package tools
+
+import (
+	_ "github.com/vektra/mockery/v2"
+	_ "golang.org/x/tools/cmd/stringer" // enums
+)
+`
+
+	s.Require().Equal([]string{"github.com/vektra/mockery/v2", "golang.org/x/tools/cmd/stringer"}, ParseToolsFile(content))
+}
+
+func (s *golangSuite) TestCompareVersions() {
+	s.Require().Equal(0, CompareVersions("1.22", "1.22.0"))
+	s.Require().Equal(-1, CompareVersions("1.21.9", "1.22"))
+	s.Require().Equal(1, CompareVersions("go1.22.4", "1.22.1"))
+	s.Require().Equal(-1, CompareVersions("1.23rc1", "1.23.0"))
+	s.Require().Equal(1, CompareVersions("1.23rc2", "1.23rc1"))
+}
+
+func (s *golangSuite) TestToolchainName() {
+	s.Require().Equal("go1.23.0", ToolchainName("1.23"))
+	s.Require().Equal("go1.23.4", ToolchainName("1.23.4"))
+	s.Require().Equal("go1.23rc1", ToolchainName("go1.23rc1"))
+	s.Require().Equal("go1.20", ToolchainName("1.20"))
+}
+
+func (s *golangSuite) TestDownloadToolchain() {
+	cmd := fakecmdexec.NewNoOpCommand()
+	c := newClientWithComponents(fakecmdexec.NewEnvCmdGenerator(cmd))
+
+	s.Require().NoError(c.DownloadToolchain("1.23"))
+	s.Require().Equal([]string{"version"}, cmd.Args())
+	s.Require().Equal([]string{"GOTOOLCHAIN=go1.23.0"}, cmd.Env())
+}
+
+func (s *golangSuite) TestHasToolchain() {
+	cmd := fakecmdexec.NewNoOpCommand()
+	c := newClientWithComponents(fakecmdexec.NewEnvCmdGenerator(cmd))
+
+	s.Require().True(c.HasToolchain("1.23"))
+	s.Require().Equal([]string{"env", "GOVERSION"}, cmd.Args())
+	s.Require().Equal([]string{"GOTOOLCHAIN=go1.23.0", "GOPROXY=off"}, cmd.Env())
+
+	missing := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{Err: errors.New("exit status 1")})
+	c = newClientWithComponents(fakecmdexec.NewEnvCmdGenerator(missing))
+
+	s.Require().False(c.HasToolchain("1.23.4"))
+}
+
+func (s *golangSuite) TestVersion() {
+	cmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: "go1.22.4\n",
+	})
+	c := newClientWithComponents(fakecmdexec.NewEnvCmdGenerator(cmd))
+
+	version, err := c.Version()
+
+	s.Require().NoError(err)
+	s.Require().Equal("1.22.4", version)
+	s.Require().Equal([]string{"env", "GOVERSION"}, cmd.Args())
+	s.Require().Equal([]string{"GOTOOLCHAIN=local"}, cmd.Env())
+}
+
+func (s *golangSuite) TestIsModCacheComplete() {
+	cmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Err: errors.New("exit status 1"),
+	})
+	c := newClientWithComponents(fakecmdexec.NewEnvCmdGenerator(cmd))
+
+	s.Require().False(c.IsModCacheComplete())
+	s.Require().Equal([]string{"mod", "download"}, cmd.Args())
+	s.Require().Equal([]string{"GOPROXY=off", "GOFLAGS=-mod=mod"}, cmd.Env())
+}
+
+func (s *golangSuite) TestBinDir() {
+	cmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: "\n/home/dev/go:/opt/go\n",
+	})
+	c := newClientWithComponents(fakecmdexec.NewEnvCmdGenerator(cmd))
+
+	dir, err := c.BinDir()
+
+	s.Require().NoError(err)
+	s.Require().Equal("/home/dev/go/bin", dir)
+}
+
+func (s *golangSuite) TestInstalledVersion() {
+	cmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: "/home/dev/go/bin/stringer: go1.22.4\n\tpath\tgolang.org/x/tools/cmd/stringer\n\tmod\tgolang.org/x/tools\tv0.20.0\th1:abc=\n",
+	})
+	c := newClientWithComponents(fakecmdexec.NewEnvCmdGenerator(cmd))
+
+	version, err := c.InstalledVersion("/home/dev/go/bin/stringer")
+
+	s.Require().NoError(err)
+	s.Require().Equal("v0.20.0", version)
+}
+
+func (s *golangSuite) TestInstall() {
+	cmd := fakecmdexec.NewNoOpCommand()
+	c := newClientWithComponents(fakecmdexec.NewEnvCmdGenerator(cmd))
+
+	s.Require().NoError(c.Install("golang.org/x/tools/cmd/stringer", "v0.20.0"))
+	s.Require().Equal("go", cmd.Cmd())
+	s.Require().Equal([]string{"install", "golang.org/x/tools/cmd/stringer@v0.20.0"}, cmd.Args())
+}
+
+func TestGolangSuite(t *testing.T) {
+	suite.Run(t, new(golangSuite))
+}
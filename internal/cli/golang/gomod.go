@@ -0,0 +1,189 @@
+package golang
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	goVersionRe = regexp.MustCompile(`^(?:go)?(\d+)(?:\.(\d+))?(?:\.(\d+))?((?:rc|beta)\d+)?$`)
+	importRe    = regexp.MustCompile(`^\s*_\s+"([^"]+)"`)
+)
+
+// GoMod holds the parts of a go.mod needed to set up a project.
+type GoMod struct {
+	Module    string
+	Go        string
+	Toolchain string
+	// Requires maps module paths to their required version.
+	Requires map[string]string
+	// Tools are the packages declared with tool directives (Go 1.24+).
+	Tools []string
+}
+
+// ParseGoMod reads the module, go, toolchain, require and tool directives
+// of a go.mod file, in their single line and block forms.
+func ParseGoMod(content string) *GoMod {
+	mod := &GoMod{
+		Requires: map[string]string{},
+		Tools:    []string{},
+	}
+
+	block := ""
+	for _, line := range strings.Split(content, "\n") {
+		line, _, _ = strings.Cut(line, "//")
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		if block != "" {
+			if fields[0] == ")" {
+				block = ""
+				continue
+			}
+
+			mod.directive(block, fields)
+			continue
+		}
+
+		if len(fields) == 2 && fields[1] == "(" {
+			block = fields[0]
+			continue
+		}
+
+		mod.directive(fields[0], fields[1:])
+	}
+
+	return mod
+}
+
+func (mod *GoMod) directive(verb string, args []string) {
+	if len(args) == 0 {
+		return
+	}
+
+	switch verb {
+	case "module":
+		mod.Module = strings.Trim(args[0], `"`)
+	case "go":
+		mod.Go = args[0]
+	case "toolchain":
+		mod.Toolchain = strings.TrimPrefix(args[0], "go")
+	case "require":
+		if len(args) >= 2 {
+			mod.Requires[strings.Trim(args[0], `"`)] = args[1]
+		}
+	case "tool":
+		mod.Tools = append(mod.Tools, strings.Trim(args[0], `"`))
+	}
+}
+
+// RequiredVersion is the minimum Go version of the module: the toolchain
+// directive when it is newer than the go one.
+func (mod *GoMod) RequiredVersion() string {
+	if mod.Toolchain != "" && CompareVersions(mod.Toolchain, mod.Go) > 0 {
+		return mod.Toolchain
+	}
+
+	return mod.Go
+}
+
+// ModuleVersion returns the required version of the module providing pkg,
+// matching the longest required module path.
+func (mod *GoMod) ModuleVersion(pkg string) (string, bool) {
+	best := ""
+	for path := range mod.Requires {
+		if (pkg == path || strings.HasPrefix(pkg, path+"/")) && len(path) > len(best) {
+			best = path
+		}
+	}
+
+	if best == "" {
+		return "", false
+	}
+
+	return mod.Requires[best], true
+}
+
+// ParseToolsFile returns the blank imports of a tools.go file, the
+// convention used to track tool dependencies before tool directives.
+func ParseToolsFile(content string) []string {
+	pkgs := []string{}
+
+	for _, line := range strings.Split(content, "\n") {
+		if matches := importRe.FindStringSubmatch(line); matches != nil {
+			pkgs = append(pkgs, matches[1])
+		}
+	}
+
+	return pkgs
+}
+
+// CompareVersions compares Go versions such as "1.22", "1.22.4", "go1.22.4"
+// or "1.23rc1", returning -1, 0 or 1. A missing patch counts as 0 and
+// release candidates come before the release. Unparsable versions compare
+// as equal.
+func CompareVersions(a, b string) int {
+	va, okA := parseVersion(a)
+	vb, okB := parseVersion(b)
+	if !okA || !okB {
+		return 0
+	}
+
+	for i := range va.parts {
+		if va.parts[i] != vb.parts[i] {
+			if va.parts[i] < vb.parts[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	switch {
+	case va.pre == vb.pre:
+		return 0
+	case va.pre == "":
+		return 1
+	case vb.pre == "":
+		return -1
+	default:
+		return strings.Compare(va.pre, vb.pre)
+	}
+}
+
+// ToolchainName returns the toolchain of a Go version, as GOTOOLCHAIN
+// expects it. Since Go 1.21, the first release of 1.N is go1.N.0, while go
+// directives are often written 1.N.
+func ToolchainName(version string) string {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "go")
+
+	matches := goVersionRe.FindStringSubmatch(version)
+	if matches != nil && matches[2] != "" && matches[3] == "" && matches[4] == "" && CompareVersions(version, "1.21") >= 0 {
+		version += ".0"
+	}
+
+	return "go" + version
+}
+
+type goVersion struct {
+	parts [3]int
+	pre   string
+}
+
+func parseVersion(version string) (goVersion, bool) {
+	matches := goVersionRe.FindStringSubmatch(strings.TrimSpace(version))
+	if matches == nil {
+		return goVersion{}, false
+	}
+
+	v := goVersion{pre: matches[4]}
+	for i := range v.parts {
+		if matches[i+1] != "" {
+			v.parts[i], _ = strconv.Atoi(matches[i+1])
+		}
+	}
+
+	return v, true
+}
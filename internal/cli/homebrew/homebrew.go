@@ -1,9 +1,12 @@
 package homebrew
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
@@ -11,29 +14,112 @@ import (
 	"github.com/renegumroad/gum-cli/internal/log"
 )
 
+var (
+	defaultClient     Client
+	defaultClientOnce sync.Once
+)
+
 type Package struct {
 	Name string
 	Cask bool
 	Link bool
 }
 
+// Service is an entry of `brew services list --json`.
+type Service struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	User   string `json:"user"`
+	File   string `json:"file"`
+}
+
+func (s Service) IsRunning() bool {
+	return s.Status == "started"
+}
+
+// PackageFailure records why a single package could not be installed.
+type PackageFailure struct {
+	Package Package
+	Err     error
+}
+
+// InstallError is returned by batch operations so that failures can be
+// attributed to the individual packages that caused them.
+type InstallError struct {
+	Failures []PackageFailure
+}
+
+func (e *InstallError) Error() string {
+	msg := fmt.Sprintf("Failed to install %d brew package(s):", len(e.Failures))
+	for _, failure := range e.Failures {
+		msg = fmt.Sprintf("%s\n  %s: %s", msg, failure.Package.Name, failure.Err)
+	}
+
+	return msg
+}
+
+func (e *InstallError) failed(pkg Package) bool {
+	for _, failure := range e.Failures {
+		if failure.Package == pkg {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Normalize returns the canonical form of a package so that equivalent
+// declarations (e.g. `homebrew/core/jq` and `jq`) compare equal.
+func Normalize(pkg Package) Package {
+	name := strings.ToLower(strings.TrimSpace(pkg.Name))
+
+	if strings.HasPrefix(name, "homebrew/cask/") {
+		pkg.Cask = true
+		name = strings.TrimPrefix(name, "homebrew/cask/")
+	}
+	name = strings.TrimPrefix(name, "homebrew/core/")
+
+	pkg.Name = name
+
+	return pkg
+}
+
 type Client interface {
 	Install(pkg Package) error
+	InstallAll(pkgs []Package) error
 	IsInstalled(pkg Package) bool
 	EnsureInstalled(pkg Package) error
+	EnsureInstalledAll(pkgs []Package) error
 	Link(pkg Package) error
 	Upgrade(pkg Package) error
+	Prefix() (string, error)
+	Inventory() (*Inventory, error)
+	Services() ([]Service, error)
+	StartService(name string) error
+	StopService(name string) error
 }
 
 type client struct {
 	fs     filesystem.Client
 	cmdGen cmdexec.EnvCmdGenerator
+	prefix string
+	inv    *Inventory
 }
 
 func New() Client {
 	return newClientWithComponents(filesystem.New(), cmdexec.NewEnvCommandGenerator())
 }
 
+// Default returns a client shared by the whole process, so that the
+// installed packages inventory is only queried once per run.
+func Default() Client {
+	defaultClientOnce.Do(func() {
+		defaultClient = New()
+	})
+
+	return defaultClient
+}
+
 func newClientWithComponents(fs filesystem.Client, gen cmdexec.EnvCmdGenerator) *client {
 	return &client{
 		fs:     fs,
@@ -42,24 +128,51 @@ func newClientWithComponents(fs filesystem.Client, gen cmdexec.EnvCmdGenerator)
 }
 
 func (c *client) EnsureInstalled(pkg Package) error {
-	log.Infof("Ensuring package %s is installed", pkg.Name)
+	return c.EnsureInstalledAll([]Package{pkg})
+}
 
-	if c.IsInstalled(pkg) {
-		log.Infof("Brew package %s is already installed", pkg.Name)
-		return nil
+func (c *client) EnsureInstalledAll(pkgs []Package) error {
+	missing := []Package{}
+	for _, pkg := range pkgs {
+		log.Infof("Ensuring package %s is installed", pkg.Name)
+
+		if c.IsInstalled(pkg) {
+			log.Infof("Brew package %s is already installed", pkg.Name)
+			continue
+		}
+
+		missing = append(missing, pkg)
 	}
 
-	if err := c.Install(pkg); err != nil {
-		return err
+	if len(missing) == 0 {
+		return nil
 	}
 
-	if pkg.Link {
-		if err := c.Link(pkg); err != nil {
+	installErr := &InstallError{}
+	if err := c.InstallAll(missing); err != nil {
+		if !errors.As(err, &installErr) {
 			return err
 		}
 	}
 
-	log.Infof("Brew package %s installed successfully", pkg.Name)
+	for _, pkg := range missing {
+		if installErr.failed(pkg) {
+			continue
+		}
+
+		if pkg.Link {
+			if err := c.Link(pkg); err != nil {
+				installErr.Failures = append(installErr.Failures, PackageFailure{Package: pkg, Err: err})
+				continue
+			}
+		}
+
+		log.Infof("Brew package %s installed successfully", pkg.Name)
+	}
+
+	if len(installErr.Failures) > 0 {
+		return installErr
+	}
 
 	return nil
 }
@@ -70,6 +183,8 @@ func (c *client) Install(pkg Package) error {
 	}
 
 	log.Debugf("Installing brew package %s", pkg.Name)
+	defer c.invalidateInventory()
+
 	args := []string{"install"}
 	if pkg.Cask {
 		args = append(args, "--cask")
@@ -78,12 +193,100 @@ func (c *client) Install(pkg Package) error {
 	return c.runBrew(args...)
 }
 
+// InstallAll installs formulas and casks with a single brew invocation each.
+// When a batch fails, the packages that are still missing are retried one by
+// one so the returned InstallError points at the actual culprits.
+func (c *client) InstallAll(pkgs []Package) error {
+	formulas := []Package{}
+	casks := []Package{}
+
+	for _, pkg := range pkgs {
+		if pkg.Name == "" {
+			return errors.Errorf("Package name is required")
+		}
+
+		if pkg.Cask {
+			casks = append(casks, pkg)
+		} else {
+			formulas = append(formulas, pkg)
+		}
+	}
+
+	installErr := &InstallError{}
+
+	for _, group := range [][]Package{formulas, casks} {
+		if len(group) == 0 {
+			continue
+		}
+
+		installErr.Failures = append(installErr.Failures, c.installGroup(group)...)
+	}
+
+	if len(installErr.Failures) > 0 {
+		return installErr
+	}
+
+	return nil
+}
+
+func (c *client) installGroup(pkgs []Package) []PackageFailure {
+	names := make([]string, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		names = append(names, pkg.Name)
+	}
+
+	log.Infof("Installing brew package(s) %s", strings.Join(names, ", "))
+
+	args := []string{"install"}
+	if pkgs[0].Cask {
+		args = append(args, "--cask")
+	}
+	args = append(args, names...)
+
+	err := c.runBrew(args...)
+	c.invalidateInventory()
+
+	if err == nil {
+		return nil
+	}
+
+	if len(pkgs) == 1 {
+		return []PackageFailure{{Package: pkgs[0], Err: err}}
+	}
+
+	log.Debugf("Batch brew install failed, retrying missing packages individually: %s", err)
+
+	failures := []PackageFailure{}
+	for _, pkg := range pkgs {
+		if c.IsInstalled(pkg) {
+			continue
+		}
+
+		if err := c.Install(pkg); err != nil {
+			failures = append(failures, PackageFailure{Package: pkg, Err: err})
+		}
+	}
+
+	return failures
+}
+
 func (c *client) IsInstalled(pkg Package) bool {
 	if pkg.Name == "" {
 		return false
 	}
 
-	prefix := os.Getenv("HOMEBREW_PREFIX")
+	inv, err := c.Inventory()
+	if err == nil {
+		return inv.Contains(pkg)
+	}
+
+	log.Debugf("Falling back to brew prefix lookup for %s: %s", pkg.Name, err)
+
+	prefix, err := c.Prefix()
+	if err != nil {
+		log.Debugf("Unable to determine brew prefix: %s", err)
+		return false
+	}
 
 	var pkgPath string
 	if pkg.Cask {
@@ -95,6 +298,56 @@ func (c *client) IsInstalled(pkg Package) bool {
 	return c.fs.Exists(pkgPath)
 }
 
+// Inventory returns the installed packages snapshot, querying brew only the
+// first time or after an operation that changed the installed packages.
+func (c *client) Inventory() (*Inventory, error) {
+	if c.inv != nil {
+		return c.inv, nil
+	}
+
+	log.Debugln("Loading installed brew packages")
+
+	out, err := c.runBrewOutput("info", "--json=v2", "--installed")
+	if err != nil {
+		return nil, err
+	}
+
+	inv, err := parseInventory(out)
+	if err != nil {
+		return nil, err
+	}
+
+	c.inv = inv
+
+	return inv, nil
+}
+
+// Prefix returns the brew installation prefix. $HOMEBREW_PREFIX is only set
+// by `brew shellenv`, so non-interactive shells fall back to `brew --prefix`.
+func (c *client) Prefix() (string, error) {
+	if prefix := os.Getenv("HOMEBREW_PREFIX"); prefix != "" {
+		return prefix, nil
+	}
+
+	if c.prefix != "" {
+		return c.prefix, nil
+	}
+
+	out, err := c.runBrewOutput("--prefix")
+	if err != nil {
+		return "", err
+	}
+
+	prefix := strings.TrimSpace(out)
+	if prefix == "" {
+		return "", errors.Errorf("brew --prefix returned an empty prefix")
+	}
+
+	c.prefix = prefix
+
+	return prefix, nil
+}
+
 func (c *client) Link(pkg Package) error {
 	if pkg.Name == "" {
 		return errors.Errorf("Package name is required")
@@ -118,6 +371,7 @@ func (c *client) Upgrade(pkg Package) error {
 	}
 
 	log.Debugf("Upgrading brew package %s", pkg.Name)
+	defer c.invalidateInventory()
 
 	args := []string{"upgrade"}
 	if pkg.Cask {
@@ -128,14 +382,62 @@ func (c *client) Upgrade(pkg Package) error {
 	return c.runBrew(args...)
 }
 
+func (c *client) Services() ([]Service, error) {
+	log.Debugln("Listing brew services")
+
+	out, err := c.runBrewOutput("services", "list", "--json")
+	if err != nil {
+		return nil, err
+	}
+
+	services := []Service{}
+	if strings.TrimSpace(out) == "" {
+		return services, nil
+	}
+
+	if err := json.Unmarshal([]byte(out), &services); err != nil {
+		return nil, errors.Errorf("Unable to parse brew services output: %s", err)
+	}
+
+	return services, nil
+}
+
+func (c *client) StartService(name string) error {
+	if name == "" {
+		return errors.Errorf("Service name is required")
+	}
+
+	log.Infof("Starting brew service %s", name)
+	return c.runBrew("services", "start", name)
+}
+
+func (c *client) StopService(name string) error {
+	if name == "" {
+		return errors.Errorf("Service name is required")
+	}
+
+	log.Infof("Stopping brew service %s", name)
+	return c.runBrew("services", "stop", name)
+}
+
+func (c *client) invalidateInventory() {
+	c.inv = nil
+}
+
 func (c *client) runBrew(args ...string) error {
+	_, err := c.runBrewOutput(args...)
+
+	return err
+}
+
+func (c *client) runBrewOutput(args ...string) (string, error) {
 	cmd := c.cmdGen("brew", args, []string{"HOMEBREW_NO_INSTALL_CLEANUP=1"})
 
 	err := cmd.Run()
 
 	if err != nil {
-		return errors.Errorf("brew %s failed:. err: %s stdout: %s stderr: %s", strings.Join(args, " "), err, cmd.Stdout(), cmd.Stderr())
+		return "", errors.Errorf("brew %s failed:. err: %s stdout: %s stderr: %s", strings.Join(args, " "), err, cmd.Stdout(), cmd.Stderr())
 	}
 
-	return nil
+	return cmd.Stdout(), nil
 }
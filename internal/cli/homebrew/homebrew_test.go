@@ -1,6 +1,7 @@
 package homebrew
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
@@ -13,6 +14,18 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
+const inventoryJSON = `{
+  "formulae": [
+    {"name": "jq", "full_name": "jq", "aliases": [], "oldnames": [], "installed": [{"version": "1.7.1"}]},
+    {"name": "postgresql@16", "full_name": "postgresql@16", "aliases": ["postgresql"], "oldnames": [], "installed": [{"version": "16.3"}]},
+    {"name": "terraform", "full_name": "hashicorp/tap/terraform", "aliases": [], "oldnames": [], "installed": [{"version": "1.9.0"}]},
+    {"name": "gh", "full_name": "gh", "aliases": [], "oldnames": [], "installed": []}
+  ],
+  "casks": [
+    {"token": "iterm2", "full_token": "iterm2", "installed": "3.5.2"}
+  ]
+}`
+
 type brewSuite struct {
 	suite.Suite
 	mockFs         *mockfilesystem.MockClient
@@ -45,12 +58,36 @@ func (s *brewSuite) SetupTest() {
 	s.caskPath = filepath.Join(path, "Caskroom")
 }
 
+func (s *brewSuite) failingCmd() fakecmdexec.SettableCommand {
+	return fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Err: errors.New("brew: command not found"),
+	})
+}
+
 func (s *brewSuite) TearDownTest() {
 	os.Setenv("HOMEBREW_PREFIX", s.origBrewPrefix)
 }
 
+func (s *brewSuite) TestIsInstalledFromInventory() {
+	infoCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: inventoryJSON,
+	})
+	s.client = newClientWithComponents(s.mockFs, fakecmdexec.NewEnvCmdGenerator(infoCmd))
+
+	s.Require().True(s.client.IsInstalled(Package{Name: "jq"}))
+	s.Require().True(s.client.IsInstalled(Package{Name: "postgresql"}))
+	s.Require().True(s.client.IsInstalled(Package{Name: "hashicorp/tap/terraform"}))
+	s.Require().True(s.client.IsInstalled(Package{Name: "iterm2", Cask: true}))
+	s.Require().False(s.client.IsInstalled(Package{Name: "iterm2"}))
+	s.Require().False(s.client.IsInstalled(Package{Name: "yq"}))
+
+	s.Require().Equal("brew", infoCmd.Cmd())
+	s.Require().Equal([]string{"info", "--json=v2", "--installed"}, infoCmd.Args())
+}
+
 func (s *brewSuite) TestIsInstalledTrue() {
 	pkg := Package{Name: "testpkg"}
+	s.client = newClientWithComponents(s.mockFs, fakecmdexec.NewEnvCmdGenerator(s.failingCmd()))
 
 	s.mockFs.EXPECT().Exists(filepath.Join(s.pkgPath, pkg.Name)).Return(true)
 
@@ -59,6 +96,7 @@ func (s *brewSuite) TestIsInstalledTrue() {
 
 func (s *brewSuite) TestIsInstalledFalse() {
 	pkg := Package{Name: "testpkg"}
+	s.client = newClientWithComponents(s.mockFs, fakecmdexec.NewEnvCmdGenerator(s.failingCmd()))
 	s.mockFs.EXPECT().Exists(filepath.Join(s.pkgPath, pkg.Name)).Return(false)
 
 	installed := s.client.IsInstalled(pkg)
@@ -72,6 +110,7 @@ func (s *brewSuite) TestIsInstalledWhenNameIsEmpty() {
 
 func (s *brewSuite) TestIsInstalledCaskTrue() {
 	pkg := Package{Name: "testpkg", Cask: true}
+	s.client = newClientWithComponents(s.mockFs, fakecmdexec.NewEnvCmdGenerator(s.failingCmd()))
 
 	s.mockFs.EXPECT().Exists(filepath.Join(s.caskPath, pkg.Name)).Return(true)
 
@@ -80,12 +119,58 @@ func (s *brewSuite) TestIsInstalledCaskTrue() {
 
 func (s *brewSuite) TestIsInstalledCaskFalse() {
 	pkg := Package{Name: "testpkg", Cask: true}
+	s.client = newClientWithComponents(s.mockFs, fakecmdexec.NewEnvCmdGenerator(s.failingCmd()))
 	s.mockFs.EXPECT().Exists(filepath.Join(s.caskPath, pkg.Name)).Return(false)
 
 	installed := s.client.IsInstalled(pkg)
 	s.Require().False(installed)
 }
 
+func (s *brewSuite) TestInventoryIsCached() {
+	infoCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: inventoryJSON,
+	})
+	s.client = newClientWithComponents(s.mockFs, fakecmdexec.NewEnvCmdGenerator(infoCmd))
+
+	s.Require().True(s.client.IsInstalled(Package{Name: "jq"}))
+	s.Require().False(s.client.IsInstalled(Package{Name: "yq"}))
+}
+
+func (s *brewSuite) TestPrefixFromEnv() {
+	prefix, err := s.client.Prefix()
+
+	s.Require().NoError(err)
+	s.Require().Equal(s.testBrewPrefix, prefix)
+}
+
+func (s *brewSuite) TestPrefixFromBrewWhenEnvMissing() {
+	os.Unsetenv("HOMEBREW_PREFIX")
+	prefixCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: "/opt/homebrew\n",
+	})
+	s.client = newClientWithComponents(s.mockFs, fakecmdexec.NewEnvCmdGenerator(prefixCmd))
+
+	prefix, err := s.client.Prefix()
+	s.Require().NoError(err)
+	s.Require().Equal("/opt/homebrew", prefix)
+	s.Require().Equal([]string{"--prefix"}, prefixCmd.Args())
+
+	prefix, err = s.client.Prefix()
+	s.Require().NoError(err)
+	s.Require().Equal("/opt/homebrew", prefix)
+}
+
+func (s *brewSuite) TestIsInstalledFallbackWithoutEnvPrefix() {
+	os.Unsetenv("HOMEBREW_PREFIX")
+	prefixCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: "/opt/homebrew\n",
+	})
+	s.client = newClientWithComponents(s.mockFs, fakecmdexec.NewEnvCmdGenerator(s.failingCmd(), prefixCmd))
+	s.mockFs.EXPECT().Exists("/opt/homebrew/opt/testpkg").Return(true)
+
+	s.Require().True(s.client.IsInstalled(Package{Name: "testpkg"}))
+}
+
 func (s *brewSuite) TestInstall() {
 	pkg := Package{Name: "testpkg"}
 	noOpCmd := fakecmdexec.NewNoOpCommand()
@@ -159,28 +244,78 @@ func (s *brewSuite) TestLinkNotLink() {
 }
 
 func (s *brewSuite) TestEnsureInstalledAlreadyInstalled() {
-	pkg := Package{Name: "testpkg"}
-	s.mockFs.EXPECT().Exists(filepath.Join(s.pkgPath, pkg.Name)).Return(true)
-
-	noOpCmd := fakecmdexec.NewNoOpCommand()
-	s.client = newClientWithComponents(s.mockFs, fakecmdexec.NewEnvCmdGenerator(noOpCmd))
+	pkg := Package{Name: "jq"}
+	infoCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: inventoryJSON,
+	})
+	s.client = newClientWithComponents(s.mockFs, fakecmdexec.NewEnvCmdGenerator(infoCmd))
 
 	err := s.client.EnsureInstalled(pkg)
 	s.Require().NoError(err)
-	s.Require().Equal("", noOpCmd.Cmd())
-	s.Require().Equal([]string{}, noOpCmd.Args())
+	s.Require().Equal([]string{"info", "--json=v2", "--installed"}, infoCmd.Args())
 }
 
 func (s *brewSuite) TestEnsureInstalledNotInstalled() {
 	pkg := Package{Name: "testpkg"}
-	s.mockFs.EXPECT().Exists(filepath.Join(s.pkgPath, pkg.Name)).Return(false)
+	infoCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: inventoryJSON,
+	})
+	installCmd := fakecmdexec.NewNoOpCommand()
+	s.client = newClientWithComponents(s.mockFs, fakecmdexec.NewEnvCmdGenerator(infoCmd, installCmd))
 
-	noOpCmd := fakecmdexec.NewNoOpCommand()
-	s.client = newClientWithComponents(s.mockFs, fakecmdexec.NewEnvCmdGenerator(noOpCmd))
 	err := s.client.EnsureInstalled(pkg)
 	s.Require().NoError(err)
-	s.Require().Equal("brew", noOpCmd.Cmd())
-	s.Require().Equal([]string{"install", "testpkg"}, noOpCmd.Args())
+	s.Require().Equal("brew", installCmd.Cmd())
+	s.Require().Equal([]string{"install", "testpkg"}, installCmd.Args())
+}
+
+func (s *brewSuite) TestEnsureInstalledAllBatchesMissingPackages() {
+	pkgs := []Package{
+		{Name: "jq"},
+		{Name: "yq"},
+		{Name: "gh", Link: true},
+		{Name: "iterm2", Cask: true},
+		{Name: "rectangle", Cask: true},
+	}
+	infoCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: inventoryJSON,
+	})
+	installFormulasCmd := fakecmdexec.NewNoOpCommand()
+	installCasksCmd := fakecmdexec.NewNoOpCommand()
+	linkCmd := fakecmdexec.NewNoOpCommand()
+	s.client = newClientWithComponents(s.mockFs, fakecmdexec.NewEnvCmdGenerator(infoCmd, installFormulasCmd, installCasksCmd, linkCmd))
+
+	err := s.client.EnsureInstalledAll(pkgs)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"install", "yq", "gh"}, installFormulasCmd.Args())
+	s.Require().Equal([]string{"install", "--cask", "rectangle"}, installCasksCmd.Args())
+	s.Require().Equal([]string{"link", "--force", "--overwrite", "gh"}, linkCmd.Args())
+}
+
+func (s *brewSuite) TestInstallAllAttributesFailures() {
+	pkgs := []Package{{Name: "yq"}, {Name: "doesnotexist"}}
+	batchCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Err: errors.New("exit status 1"),
+	})
+	infoCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: `{"formulae":[{"name":"yq","full_name":"yq","installed":[{"version":"4.44.2"}]}],"casks":[]}`,
+	})
+	retryCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stderr: "Error: No available formula with the name \"doesnotexist\".",
+		Err:    errors.New("exit status 1"),
+	})
+	s.client = newClientWithComponents(s.mockFs, fakecmdexec.NewEnvCmdGenerator(batchCmd, infoCmd, retryCmd))
+
+	err := s.client.InstallAll(pkgs)
+
+	s.Require().Error(err)
+	installErr := &InstallError{}
+	s.Require().ErrorAs(err, &installErr)
+	s.Require().Len(installErr.Failures, 1)
+	s.Require().Equal("doesnotexist", installErr.Failures[0].Package.Name)
+	s.Require().ErrorContains(installErr.Failures[0].Err, "No available formula")
+	s.Require().Equal([]string{"install", "yq", "doesnotexist"}, batchCmd.Args())
+	s.Require().Equal([]string{"install", "doesnotexist"}, retryCmd.Args())
 }
 
 func (s *brewSuite) TestUpgrade() {
@@ -219,6 +354,49 @@ func (s *brewSuite) TestUpgradeMissingName() {
 	s.Require().Equal([]string{}, noOpCmd.Args())
 }
 
+func (s *brewSuite) TestServices() {
+	listCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: `[{"name":"postgresql@16","status":"started","user":"dev","file":"/Users/dev/Library/LaunchAgents/homebrew.mxcl.postgresql@16.plist","exit_code":0},{"name":"redis","status":"none","user":null,"file":"/opt/homebrew/opt/redis/homebrew.mxcl.redis.plist","exit_code":null}]`,
+	})
+	s.client = newClientWithComponents(s.mockFs, fakecmdexec.NewEnvCmdGenerator(listCmd))
+
+	services, err := s.client.Services()
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"services", "list", "--json"}, listCmd.Args())
+	s.Require().Len(services, 2)
+	s.Require().Equal("postgresql@16", services[0].Name)
+	s.Require().True(services[0].IsRunning())
+	s.Require().Equal("redis", services[1].Name)
+	s.Require().False(services[1].IsRunning())
+}
+
+func (s *brewSuite) TestServicesEmptyOutput() {
+	listCmd := fakecmdexec.NewNoOpCommand()
+	s.client = newClientWithComponents(s.mockFs, fakecmdexec.NewEnvCmdGenerator(listCmd))
+
+	services, err := s.client.Services()
+	s.Require().NoError(err)
+	s.Require().Empty(services)
+}
+
+func (s *brewSuite) TestStartAndStopService() {
+	startCmd := fakecmdexec.NewNoOpCommand()
+	stopCmd := fakecmdexec.NewNoOpCommand()
+	s.client = newClientWithComponents(s.mockFs, fakecmdexec.NewEnvCmdGenerator(startCmd, stopCmd))
+
+	s.Require().NoError(s.client.StartService("redis"))
+	s.Require().NoError(s.client.StopService("redis"))
+	s.Require().Equal([]string{"services", "start", "redis"}, startCmd.Args())
+	s.Require().Equal([]string{"services", "stop", "redis"}, stopCmd.Args())
+	s.Require().Error(s.client.StartService(""))
+}
+
+func (s *brewSuite) TestNormalize() {
+	s.Require().Equal(Package{Name: "jq"}, Normalize(Package{Name: " homebrew/core/JQ "}))
+	s.Require().Equal(Package{Name: "iterm2", Cask: true}, Normalize(Package{Name: "homebrew/cask/iterm2"}))
+	s.Require().Equal(Package{Name: "hashicorp/tap/terraform", Link: true}, Normalize(Package{Name: "hashicorp/tap/terraform", Link: true}))
+}
+
 func TestBrewSuite(t *testing.T) {
 	suite.Run(t, &brewSuite{})
 }
@@ -0,0 +1,81 @@
+package homebrew
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Inventory is a snapshot of the formulas and casks installed by brew,
+// as reported by `brew info --json=v2 --installed`.
+type Inventory struct {
+	formulas map[string]bool
+	casks    map[string]bool
+}
+
+type infoOutput struct {
+	Formulae []struct {
+		Name      string   `json:"name"`
+		FullName  string   `json:"full_name"`
+		Aliases   []string `json:"aliases"`
+		Oldnames  []string `json:"oldnames"`
+		Installed []struct {
+			Version string `json:"version"`
+		} `json:"installed"`
+	} `json:"formulae"`
+	Casks []struct {
+		Token     string `json:"token"`
+		FullToken string `json:"full_token"`
+		Installed string `json:"installed"`
+	} `json:"casks"`
+}
+
+func parseInventory(data string) (*Inventory, error) {
+	out := &infoOutput{}
+
+	if err := json.Unmarshal([]byte(data), out); err != nil {
+		return nil, errors.Errorf("Unable to parse brew info output: %s", err)
+	}
+
+	inv := &Inventory{
+		formulas: map[string]bool{},
+		casks:    map[string]bool{},
+	}
+
+	for _, formula := range out.Formulae {
+		if len(formula.Installed) == 0 {
+			continue
+		}
+
+		names := append([]string{formula.Name, formula.FullName}, formula.Aliases...)
+		names = append(names, formula.Oldnames...)
+		for _, name := range names {
+			if name != "" {
+				inv.formulas[name] = true
+			}
+		}
+	}
+
+	for _, cask := range out.Casks {
+		if cask.Installed == "" {
+			continue
+		}
+
+		for _, name := range []string{cask.Token, cask.FullToken} {
+			if name != "" {
+				inv.casks[name] = true
+			}
+		}
+	}
+
+	return inv, nil
+}
+
+// Contains reports whether the package is present in the snapshot.
+func (inv *Inventory) Contains(pkg Package) bool {
+	if pkg.Cask {
+		return inv.casks[pkg.Name]
+	}
+
+	return inv.formulas[pkg.Name]
+}
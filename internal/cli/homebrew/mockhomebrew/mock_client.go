@@ -66,6 +66,52 @@ func (_c *MockClient_EnsureInstalled_Call) RunAndReturn(run func(homebrew.Packag
 	return _c
 }
 
+// EnsureInstalledAll provides a mock function with given fields: pkgs
+func (_m *MockClient) EnsureInstalledAll(pkgs []homebrew.Package) error {
+	ret := _m.Called(pkgs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureInstalledAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]homebrew.Package) error); ok {
+		r0 = rf(pkgs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClient_EnsureInstalledAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnsureInstalledAll'
+type MockClient_EnsureInstalledAll_Call struct {
+	*mock.Call
+}
+
+// EnsureInstalledAll is a helper method to define mock.On call
+//   - pkgs []homebrew.Package
+func (_e *MockClient_Expecter) EnsureInstalledAll(pkgs interface{}) *MockClient_EnsureInstalledAll_Call {
+	return &MockClient_EnsureInstalledAll_Call{Call: _e.mock.On("EnsureInstalledAll", pkgs)}
+}
+
+func (_c *MockClient_EnsureInstalledAll_Call) Run(run func(pkgs []homebrew.Package)) *MockClient_EnsureInstalledAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]homebrew.Package))
+	})
+	return _c
+}
+
+func (_c *MockClient_EnsureInstalledAll_Call) Return(_a0 error) *MockClient_EnsureInstalledAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClient_EnsureInstalledAll_Call) RunAndReturn(run func([]homebrew.Package) error) *MockClient_EnsureInstalledAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Install provides a mock function with given fields: pkg
 func (_m *MockClient) Install(pkg homebrew.Package) error {
 	ret := _m.Called(pkg)
@@ -112,6 +158,109 @@ func (_c *MockClient_Install_Call) RunAndReturn(run func(homebrew.Package) error
 	return _c
 }
 
+// InstallAll provides a mock function with given fields: pkgs
+func (_m *MockClient) InstallAll(pkgs []homebrew.Package) error {
+	ret := _m.Called(pkgs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InstallAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]homebrew.Package) error); ok {
+		r0 = rf(pkgs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClient_InstallAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InstallAll'
+type MockClient_InstallAll_Call struct {
+	*mock.Call
+}
+
+// InstallAll is a helper method to define mock.On call
+//   - pkgs []homebrew.Package
+func (_e *MockClient_Expecter) InstallAll(pkgs interface{}) *MockClient_InstallAll_Call {
+	return &MockClient_InstallAll_Call{Call: _e.mock.On("InstallAll", pkgs)}
+}
+
+func (_c *MockClient_InstallAll_Call) Run(run func(pkgs []homebrew.Package)) *MockClient_InstallAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]homebrew.Package))
+	})
+	return _c
+}
+
+func (_c *MockClient_InstallAll_Call) Return(_a0 error) *MockClient_InstallAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClient_InstallAll_Call) RunAndReturn(run func([]homebrew.Package) error) *MockClient_InstallAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Inventory provides a mock function with given fields:
+func (_m *MockClient) Inventory() (*homebrew.Inventory, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Inventory")
+	}
+
+	var r0 *homebrew.Inventory
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*homebrew.Inventory, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *homebrew.Inventory); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*homebrew.Inventory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_Inventory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Inventory'
+type MockClient_Inventory_Call struct {
+	*mock.Call
+}
+
+// Inventory is a helper method to define mock.On call
+func (_e *MockClient_Expecter) Inventory() *MockClient_Inventory_Call {
+	return &MockClient_Inventory_Call{Call: _e.mock.On("Inventory")}
+}
+
+func (_c *MockClient_Inventory_Call) Run(run func()) *MockClient_Inventory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockClient_Inventory_Call) Return(_a0 *homebrew.Inventory, _a1 error) *MockClient_Inventory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClient_Inventory_Call) RunAndReturn(run func() (*homebrew.Inventory, error)) *MockClient_Inventory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsInstalled provides a mock function with given fields: pkg
 func (_m *MockClient) IsInstalled(pkg homebrew.Package) bool {
 	ret := _m.Called(pkg)
@@ -204,6 +353,210 @@ func (_c *MockClient_Link_Call) RunAndReturn(run func(homebrew.Package) error) *
 	return _c
 }
 
+// Prefix provides a mock function with given fields:
+func (_m *MockClient) Prefix() (string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Prefix")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_Prefix_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Prefix'
+type MockClient_Prefix_Call struct {
+	*mock.Call
+}
+
+// Prefix is a helper method to define mock.On call
+func (_e *MockClient_Expecter) Prefix() *MockClient_Prefix_Call {
+	return &MockClient_Prefix_Call{Call: _e.mock.On("Prefix")}
+}
+
+func (_c *MockClient_Prefix_Call) Run(run func()) *MockClient_Prefix_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockClient_Prefix_Call) Return(_a0 string, _a1 error) *MockClient_Prefix_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClient_Prefix_Call) RunAndReturn(run func() (string, error)) *MockClient_Prefix_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Services provides a mock function with given fields:
+func (_m *MockClient) Services() ([]homebrew.Service, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Services")
+	}
+
+	var r0 []homebrew.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]homebrew.Service, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []homebrew.Service); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]homebrew.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_Services_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Services'
+type MockClient_Services_Call struct {
+	*mock.Call
+}
+
+// Services is a helper method to define mock.On call
+func (_e *MockClient_Expecter) Services() *MockClient_Services_Call {
+	return &MockClient_Services_Call{Call: _e.mock.On("Services")}
+}
+
+func (_c *MockClient_Services_Call) Run(run func()) *MockClient_Services_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockClient_Services_Call) Return(_a0 []homebrew.Service, _a1 error) *MockClient_Services_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClient_Services_Call) RunAndReturn(run func() ([]homebrew.Service, error)) *MockClient_Services_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartService provides a mock function with given fields: name
+func (_m *MockClient) StartService(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartService")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClient_StartService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartService'
+type MockClient_StartService_Call struct {
+	*mock.Call
+}
+
+// StartService is a helper method to define mock.On call
+//   - name string
+func (_e *MockClient_Expecter) StartService(name interface{}) *MockClient_StartService_Call {
+	return &MockClient_StartService_Call{Call: _e.mock.On("StartService", name)}
+}
+
+func (_c *MockClient_StartService_Call) Run(run func(name string)) *MockClient_StartService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_StartService_Call) Return(_a0 error) *MockClient_StartService_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClient_StartService_Call) RunAndReturn(run func(string) error) *MockClient_StartService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopService provides a mock function with given fields: name
+func (_m *MockClient) StopService(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopService")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClient_StopService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopService'
+type MockClient_StopService_Call struct {
+	*mock.Call
+}
+
+// StopService is a helper method to define mock.On call
+//   - name string
+func (_e *MockClient_Expecter) StopService(name interface{}) *MockClient_StopService_Call {
+	return &MockClient_StopService_Call{Call: _e.mock.On("StopService", name)}
+}
+
+func (_c *MockClient_StopService_Call) Run(run func(name string)) *MockClient_StopService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_StopService_Call) Return(_a0 error) *MockClient_StopService_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClient_StopService_Call) RunAndReturn(run func(string) error) *MockClient_StopService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Upgrade provides a mock function with given fields: pkg
 func (_m *MockClient) Upgrade(pkg homebrew.Package) error {
 	ret := _m.Called(pkg)
@@ -0,0 +1,115 @@
+package java
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+)
+
+var versionOutputRe = regexp.MustCompile(`version "([^"]+)"`)
+
+// Client locates installed JDKs and reports their versions.
+type Client interface {
+	Home(major int) (string, error)
+	Version(home string) (int, error)
+}
+
+type client struct {
+	cmdGen cmdexec.CmdGenerator
+	sys    systeminfo.Client
+	glob   func(pattern string) ([]string, error)
+}
+
+func New() Client {
+	return newClientWithComponents(cmdexec.NewCommandGenerator(), systeminfo.New(), filepath.Glob)
+}
+
+func newClientWithComponents(gen cmdexec.CmdGenerator, sys systeminfo.Client, glob func(pattern string) ([]string, error)) *client {
+	return &client{
+		cmdGen: gen,
+		sys:    sys,
+		glob:   glob,
+	}
+}
+
+// Home returns the JAVA_HOME of the installed JDK with the given major
+// version. macOS knows the installed JDKs through java_home, while Linux
+// distributions install them into /usr/lib/jvm.
+func (c *client) Home(major int) (string, error) {
+	if c.sys.IsMacOS() {
+		cmd := c.cmdGen("/usr/libexec/java_home", "-F", "-v", strconv.Itoa(major))
+		if err := cmd.Run(); err != nil {
+			return "", errors.Errorf("No JDK %d is installed: %s %s", major, err, cmd.Stderr())
+		}
+
+		return strings.TrimSpace(cmd.Stdout()), nil
+	}
+
+	patterns := []string{
+		fmt.Sprintf("/usr/lib/jvm/java-%d-openjdk*", major),
+		fmt.Sprintf("/usr/lib/jvm/java-%d-*", major),
+		fmt.Sprintf("/usr/lib/jvm/jdk-%d*", major),
+	}
+	for _, pattern := range patterns {
+		matches, err := c.glob(pattern)
+		if err != nil {
+			return "", errors.Errorf("Failed to look for JDK %d: %s", major, err)
+		}
+
+		if len(matches) > 0 {
+			slices.Sort(matches)
+			return matches[0], nil
+		}
+	}
+
+	return "", errors.Errorf("No JDK %d is installed in /usr/lib/jvm", major)
+}
+
+// Version returns the major version reported by `java -version` of the JDK
+// in home. java prints its version on stderr.
+func (c *client) Version(home string) (int, error) {
+	cmd := c.cmdGen(filepath.Join(home, "bin", "java"), "-version")
+	if err := cmd.Run(); err != nil {
+		return 0, errors.Errorf("Failed java -version: %s %s", err, cmd.Stderr())
+	}
+
+	return ParseVersionOutput(cmd.Stderr() + cmd.Stdout())
+}
+
+// ParseVersionOutput reads the major version from the output of
+// `java -version`, such as `openjdk version "17.0.11" 2024-04-16`.
+func ParseVersionOutput(out string) (int, error) {
+	matches := versionOutputRe.FindStringSubmatch(out)
+	if matches == nil {
+		return 0, errors.Errorf("Unable to find the java version in %q", strings.TrimSpace(out))
+	}
+
+	return ParseMajorVersion(matches[1])
+}
+
+// ParseMajorVersion returns the major version of a java version string.
+// Versions before 9 are numbered 1.<major>, and sdkman identifiers carry a
+// vendor suffix, as in 17.0.11-tem.
+func ParseMajorVersion(version string) (int, error) {
+	version = strings.TrimSpace(version)
+	version, _, _ = strings.Cut(version, "-")
+	version = strings.TrimPrefix(version, "1.")
+
+	majorStr, _, _ := strings.Cut(version, ".")
+	majorStr, _, _ = strings.Cut(majorStr, "_")
+	majorStr, _, _ = strings.Cut(majorStr, "+")
+
+	major, err := strconv.Atoi(majorStr)
+	if err != nil || major <= 0 {
+		return 0, errors.Errorf("Invalid java version %q", version)
+	}
+
+	return major, nil
+}
@@ -0,0 +1,111 @@
+package java
+
+import (
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec/fakecmdexec"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo/mocksysteminfo"
+	"github.com/stretchr/testify/suite"
+)
+
+type javaSuite struct {
+	suite.Suite
+	mockSys *mocksysteminfo.MockClient
+}
+
+func (s *javaSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *javaSuite) SetupTest() {
+	s.mockSys = mocksysteminfo.NewMockClient(s.T())
+}
+
+func (s *javaSuite) TestParseVersionOutput() {
+	cases := map[string]int{
+		"openjdk version \"17.0.11\" 2024-04-16\nOpenJDK Runtime Environment Temurin-17.0.11+9":     17,
+		"openjdk version \"1.8.0_412\"\nOpenJDK Runtime Environment (Temurin)(build 1.8.0_412-b08)": 8,
+		"java version \"21\" 2023-09-19 LTS":                                                        21,
+		"openjdk version \"22-ea\" 2024-03-19":                                                      22,
+	}
+
+	for out, expected := range cases {
+		major, err := ParseVersionOutput(out)
+		s.Require().NoError(err, out)
+		s.Require().Equal(expected, major, out)
+	}
+
+	_, err := ParseVersionOutput("command not found")
+	s.Require().Error(err)
+}
+
+func (s *javaSuite) TestParseVersionFiles() {
+	major, err := ParseJavaVersionFile("17.0\n")
+	s.Require().NoError(err)
+	s.Require().Equal(17, major)
+
+	major, err = ParseJavaVersionFile("temurin64-11.0.23\n")
+	s.Require().NoError(err)
+	s.Require().Equal(11, major)
+
+	major, err = ParseSdkmanrc("# Enable auto-env\njava=21.0.3-tem\ngradle=8.7\n")
+	s.Require().NoError(err)
+	s.Require().Equal(21, major)
+
+	major, err = ParseSdkmanrc("gradle=8.7\n")
+	s.Require().NoError(err)
+	s.Require().Zero(major)
+
+	_, err = ParseSdkmanrc("java=latest\n")
+	s.Require().Error(err)
+}
+
+func (s *javaSuite) TestHomeOnMacOS() {
+	s.mockSys.EXPECT().IsMacOS().Return(true)
+	cmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: "/Library/Java/JavaVirtualMachines/temurin-17.jdk/Contents/Home\n",
+	})
+	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(cmd), s.mockSys, nil)
+
+	home, err := client.Home(17)
+
+	s.Require().NoError(err)
+	s.Require().Equal("/Library/Java/JavaVirtualMachines/temurin-17.jdk/Contents/Home", home)
+	s.Require().Equal("/usr/libexec/java_home", cmd.Cmd())
+	s.Require().Equal([]string{"-F", "-v", "17"}, cmd.Args())
+}
+
+func (s *javaSuite) TestHomeOnLinux() {
+	s.mockSys.EXPECT().IsMacOS().Return(false)
+	glob := func(pattern string) ([]string, error) {
+		if pattern == "/usr/lib/jvm/java-17-openjdk*" {
+			return []string{"/usr/lib/jvm/java-17-openjdk-arm64", "/usr/lib/jvm/java-17-openjdk-amd64"}, nil
+		}
+		return nil, nil
+	}
+	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(), s.mockSys, glob)
+
+	home, err := client.Home(17)
+
+	s.Require().NoError(err)
+	s.Require().Equal("/usr/lib/jvm/java-17-openjdk-amd64", home)
+}
+
+func (s *javaSuite) TestVersionReadsStderr() {
+	cmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stderr: "openjdk version \"17.0.11\" 2024-04-16\n",
+	})
+	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(cmd), s.mockSys, nil)
+
+	major, err := client.Version("/usr/lib/jvm/java-17-openjdk-amd64")
+
+	s.Require().NoError(err)
+	s.Require().Equal(17, major)
+	s.Require().Equal("/usr/lib/jvm/java-17-openjdk-amd64/bin/java", cmd.Cmd())
+}
+
+func TestJavaSuite(t *testing.T) {
+	suite.Run(t, new(javaSuite))
+}
@@ -0,0 +1,41 @@
+package java
+
+import (
+	"strings"
+)
+
+// ParseJavaVersionFile reads the version of a .java-version file, as used by
+// jenv and asdf.
+func ParseJavaVersionFile(content string) (int, error) {
+	for _, line := range strings.Split(content, "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			// jenv names versions after their vendor, as in temurin64-17.0.11
+			if idx := strings.LastIndex(line, "-"); idx >= 0 && !startsWithDigit(line) {
+				line = line[idx+1:]
+			}
+
+			return ParseMajorVersion(line)
+		}
+	}
+
+	return 0, nil
+}
+
+// ParseSdkmanrc reads the java entry of a .sdkmanrc file, such as
+// java=17.0.11-tem. It returns 0 when there is none.
+func ParseSdkmanrc(content string) (int, error) {
+	for _, line := range strings.Split(content, "\n") {
+		key, value, found := strings.Cut(strings.TrimSpace(line), "=")
+		if !found || strings.TrimSpace(key) != "java" {
+			continue
+		}
+
+		return ParseMajorVersion(value)
+	}
+
+	return 0, nil
+}
+
+func startsWithDigit(s string) bool {
+	return s != "" && s[0] >= '0' && s[0] <= '9'
+}
@@ -0,0 +1,239 @@
+package nodepm
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/lockhash"
+	"github.com/renegumroad/gum-cli/internal/log"
+)
+
+var (
+	Npm  = "npm"
+	Yarn = "yarn"
+	Pnpm = "pnpm"
+
+	packageJSONFile = "package.json"
+	yarnBerryFile   = ".yarnrc.yml"
+
+	// hashFile records the hash of the lockfile of the last successful
+	// install. It lives in node_modules so that removing the dependencies
+	// also invalidates it.
+	hashFile = filepath.Join("node_modules", ".gum-lockfile-hash")
+
+	// lockfiles are checked in order, the first one found decides the
+	// package manager.
+	lockfiles = []struct {
+		manager string
+		file    string
+	}{
+		{Pnpm, "pnpm-lock.yaml"},
+		{Yarn, "yarn.lock"},
+		{Npm, "package-lock.json"},
+	}
+)
+
+// Client installs the dependencies of a JavaScript project with the package
+// manager matching its lockfile.
+type Client interface {
+	HasPackageJSON() bool
+	PackageManager() (string, error)
+	EnableCorepack() error
+	IsInstallCurrent() bool
+	Install() error
+}
+
+type client struct {
+	fs     filesystem.Client
+	cmdGen cmdexec.CmdGenerator
+}
+
+func New() Client {
+	return newClientWithComponents(
+		filesystem.New(),
+		cmdexec.NewCommandGenerator(),
+	)
+}
+
+func newClientWithComponents(
+	fs filesystem.Client,
+	cmdGen cmdexec.CmdGenerator,
+) *client {
+	return &client{
+		fs:     fs,
+		cmdGen: cmdGen,
+	}
+}
+
+func (c *client) HasPackageJSON() bool {
+	dir, err := c.fs.CurrentDir()
+	if err != nil {
+		log.Debugf("Unable to check for %s: %s", packageJSONFile, err)
+		return false
+	}
+
+	return c.fs.Exists(filepath.Join(dir, packageJSONFile))
+}
+
+// PackageManager detects the package manager from the lockfile, defaulting
+// to npm when there is none.
+func (c *client) PackageManager() (string, error) {
+	lockfile, err := c.lockfile()
+	if err != nil {
+		return "", err
+	}
+
+	for _, lock := range lockfiles {
+		if lock.file == lockfile {
+			return lock.manager, nil
+		}
+	}
+
+	return Npm, nil
+}
+
+// EnableCorepack makes the yarn and pnpm versions pinned by the project
+// available through corepack, which ships with node.
+func (c *client) EnableCorepack() error {
+	log.Infof("Enabling corepack")
+
+	cmd := c.cmdGen("corepack", "enable")
+	if err := cmd.Run(); err != nil {
+		return errors.Errorf("Failed to enable corepack: %s %s", err, cmd.Stderr())
+	}
+
+	return nil
+}
+
+// IsInstallCurrent reports whether the dependencies were installed from the
+// current lockfile.
+func (c *client) IsInstallCurrent() bool {
+	if !c.HasPackageJSON() {
+		return true
+	}
+
+	dir, err := c.fs.CurrentDir()
+	if err != nil {
+		log.Debugf("Unable to check the installed dependencies: %s", err)
+		return false
+	}
+
+	lockfile, err := c.hashedFile()
+	if err != nil {
+		log.Debugf("%s", err)
+		return false
+	}
+
+	return lockhash.IsCurrent(c.fs, filepath.Join(dir, lockfile), filepath.Join(dir, hashFile))
+}
+
+// Install runs a frozen lockfile install and records the lockfile hash. Without
+// a lockfile it runs a plain npm install, keyed on package.json instead.
+func (c *client) Install() error {
+	if !c.HasPackageJSON() {
+		log.Infof("No %s found, skipping dependencies installation", packageJSONFile)
+		return nil
+	}
+
+	manager, err := c.PackageManager()
+	if err != nil {
+		return err
+	}
+
+	args, err := c.installArgs(manager)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Running %s %s", manager, strings.Join(args, " "))
+
+	cmd := c.cmdGen(manager, args...)
+	if err := cmd.Run(); err != nil {
+		return errors.Errorf("Failed to install node dependencies: err: %s; stdout: %s; stderr: %s", err, cmd.Stdout(), cmd.Stderr())
+	}
+
+	return c.recordInstall()
+}
+
+func (c *client) installArgs(manager string) ([]string, error) {
+	lockfile, err := c.lockfile()
+	if err != nil {
+		return nil, err
+	}
+
+	if lockfile == "" {
+		return []string{"install"}, nil
+	}
+
+	switch manager {
+	case Yarn:
+		dir, err := c.fs.CurrentDir()
+		if err != nil {
+			return nil, err
+		}
+
+		// yarn 2+ is configured with .yarnrc.yml and renamed the flag
+		if c.fs.Exists(filepath.Join(dir, yarnBerryFile)) {
+			return []string{"install", "--immutable"}, nil
+		}
+
+		return []string{"install", "--frozen-lockfile"}, nil
+	case Pnpm:
+		return []string{"install", "--frozen-lockfile"}, nil
+	default:
+		return []string{"ci"}, nil
+	}
+}
+
+func (c *client) recordInstall() error {
+	dir, err := c.fs.CurrentDir()
+	if err != nil {
+		return err
+	}
+
+	lockfile, err := c.hashedFile()
+	if err != nil {
+		return err
+	}
+
+	if err := lockhash.Record(c.fs, filepath.Join(dir, lockfile), filepath.Join(dir, hashFile)); err != nil {
+		return errors.Errorf("Failed to record node dependencies installation: %s", err)
+	}
+
+	return nil
+}
+
+// lockfile returns the name of the lockfile in the current directory or an
+// empty string when there is none.
+func (c *client) lockfile() (string, error) {
+	dir, err := c.fs.CurrentDir()
+	if err != nil {
+		return "", err
+	}
+
+	for _, lock := range lockfiles {
+		if c.fs.Exists(filepath.Join(dir, lock.file)) {
+			return lock.file, nil
+		}
+	}
+
+	return "", nil
+}
+
+// hashedFile returns the file keying the installed dependencies: the
+// lockfile, or package.json when there is none.
+func (c *client) hashedFile() (string, error) {
+	lockfile, err := c.lockfile()
+	if err != nil {
+		return "", err
+	}
+
+	if lockfile == "" {
+		return packageJSONFile, nil
+	}
+
+	return lockfile, nil
+}
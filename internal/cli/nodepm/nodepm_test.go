@@ -0,0 +1,147 @@
+package nodepm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec/fakecmdexec"
+	"github.com/renegumroad/gum-cli/internal/filesystem/mockfilesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type nodepmSuite struct {
+	suite.Suite
+	mockFs *mockfilesystem.MockClient
+}
+
+func (s *nodepmSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *nodepmSuite) SetupTest() {
+	s.mockFs = mockfilesystem.NewMockClient(s.T())
+	s.mockFs.EXPECT().CurrentDir().Return("/app", nil).Maybe()
+}
+
+func (s *nodepmSuite) withFiles(files ...string) {
+	present := map[string]bool{}
+	for _, file := range files {
+		present["/app/"+file] = true
+	}
+
+	s.mockFs.EXPECT().Exists(mock.Anything).RunAndReturn(func(path string) bool {
+		return present[path]
+	}).Maybe()
+}
+
+func hashOf(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *nodepmSuite) TestPackageManagerFromLockfile() {
+	s.withFiles("package.json", "yarn.lock", "package-lock.json")
+	c := newClientWithComponents(s.mockFs, nil)
+
+	manager, err := c.PackageManager()
+
+	s.Require().NoError(err)
+	s.Require().Equal(Yarn, manager)
+}
+
+func (s *nodepmSuite) TestPackageManagerDefaultsToNpm() {
+	s.withFiles("package.json")
+	c := newClientWithComponents(s.mockFs, nil)
+
+	manager, err := c.PackageManager()
+
+	s.Require().NoError(err)
+	s.Require().Equal(Npm, manager)
+}
+
+func (s *nodepmSuite) TestIsInstallCurrent() {
+	s.withFiles("package.json", "pnpm-lock.yaml", "node_modules/.gum-lockfile-hash")
+	s.mockFs.EXPECT().ReadString("/app/node_modules/.gum-lockfile-hash").Return(hashOf("lockfileVersion: '9.0'\n")+"\n", nil)
+	s.mockFs.EXPECT().ReadString("/app/pnpm-lock.yaml").Return("lockfileVersion: '9.0'\n", nil)
+	c := newClientWithComponents(s.mockFs, nil)
+
+	s.Require().True(c.IsInstallCurrent())
+}
+
+func (s *nodepmSuite) TestIsInstallCurrentLockfileChanged() {
+	s.withFiles("package.json", "pnpm-lock.yaml", "node_modules/.gum-lockfile-hash")
+	s.mockFs.EXPECT().ReadString("/app/node_modules/.gum-lockfile-hash").Return(hashOf("old"), nil)
+	s.mockFs.EXPECT().ReadString("/app/pnpm-lock.yaml").Return("lockfileVersion: '9.0'\n", nil)
+	c := newClientWithComponents(s.mockFs, nil)
+
+	s.Require().False(c.IsInstallCurrent())
+}
+
+func (s *nodepmSuite) TestIsInstallCurrentNeverInstalled() {
+	s.withFiles("package.json", "package-lock.json")
+	c := newClientWithComponents(s.mockFs, nil)
+
+	s.Require().False(c.IsInstallCurrent())
+}
+
+func (s *nodepmSuite) TestInstallNpm() {
+	s.withFiles("package.json", "package-lock.json")
+	s.mockFs.EXPECT().ReadString("/app/package-lock.json").Return("{}", nil)
+	s.mockFs.EXPECT().MkdirAll("/app/node_modules").Return(nil)
+	s.mockFs.EXPECT().WriteString("/app/node_modules/.gum-lockfile-hash", hashOf("{}")).Return(nil)
+	installCmd := fakecmdexec.NewNoOpCommand()
+	c := newClientWithComponents(s.mockFs, fakecmdexec.NewCmdGenerator(installCmd))
+
+	s.Require().NoError(c.Install())
+	s.Require().Equal("npm", installCmd.Cmd())
+	s.Require().Equal([]string{"ci"}, installCmd.Args())
+}
+
+func (s *nodepmSuite) TestInstallYarnBerry() {
+	s.withFiles("package.json", "yarn.lock", ".yarnrc.yml")
+	s.mockFs.EXPECT().ReadString("/app/yarn.lock").Return("__metadata:\n", nil)
+	s.mockFs.EXPECT().MkdirAll("/app/node_modules").Return(nil)
+	s.mockFs.EXPECT().WriteString("/app/node_modules/.gum-lockfile-hash", hashOf("__metadata:\n")).Return(nil)
+	installCmd := fakecmdexec.NewNoOpCommand()
+	c := newClientWithComponents(s.mockFs, fakecmdexec.NewCmdGenerator(installCmd))
+
+	s.Require().NoError(c.Install())
+	s.Require().Equal("yarn", installCmd.Cmd())
+	s.Require().Equal([]string{"install", "--immutable"}, installCmd.Args())
+}
+
+func (s *nodepmSuite) TestInstallWithoutLockfile() {
+	s.withFiles("package.json")
+	s.mockFs.EXPECT().ReadString("/app/package.json").Return(`{"name": "web"}`, nil)
+	s.mockFs.EXPECT().MkdirAll("/app/node_modules").Return(nil)
+	s.mockFs.EXPECT().WriteString("/app/node_modules/.gum-lockfile-hash", hashOf(`{"name": "web"}`)).Return(nil)
+	installCmd := fakecmdexec.NewNoOpCommand()
+	c := newClientWithComponents(s.mockFs, fakecmdexec.NewCmdGenerator(installCmd))
+
+	s.Require().NoError(c.Install())
+	s.Require().Equal([]string{"install"}, installCmd.Args())
+}
+
+func (s *nodepmSuite) TestInstallFailureDoesNotRecordHash() {
+	s.withFiles("package.json", "pnpm-lock.yaml")
+	installCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stderr: "ERR_PNPM_OUTDATED_LOCKFILE",
+		Err:    errors.New("exit status 1"),
+	})
+	c := newClientWithComponents(s.mockFs, fakecmdexec.NewCmdGenerator(installCmd))
+
+	err := c.Install()
+
+	s.Require().ErrorContains(err, "ERR_PNPM_OUTDATED_LOCKFILE")
+	s.Require().Equal([]string{"install", "--frozen-lockfile"}, installCmd.Args())
+	s.mockFs.AssertNotCalled(s.T(), "WriteString", mock.Anything, mock.Anything)
+}
+
+func TestNodepmSuite(t *testing.T) {
+	suite.Run(t, new(nodepmSuite))
+}
@@ -0,0 +1,33 @@
+package pkgmanager
+
+import "strings"
+
+func aptBackend() backend {
+	return backend{
+		name:    "apt",
+		list:    []string{"dpkg-query", "--show", "--showformat=${Package}\t${db:Status-Abbrev}\n"},
+		parse:   parseDpkgQuery,
+		update:  []string{"apt-get", "update"},
+		install: []string{"apt-get", "install", "--yes", "--no-install-recommends"},
+		env:     []string{"DEBIAN_FRONTEND=noninteractive"},
+	}
+}
+
+// parseDpkgQuery keeps the packages whose status is "ii" (installed). Removed
+// packages that still have configuration files are listed as "rc".
+func parseDpkgQuery(out string) map[string]bool {
+	installed := map[string]bool{}
+
+	for _, line := range strings.Split(out, "\n") {
+		name, status, found := strings.Cut(line, "\t")
+		if !found || !strings.HasPrefix(status, "ii") {
+			continue
+		}
+
+		// Multi-arch packages are reported as name:arch
+		name, _, _ = strings.Cut(name, ":")
+		installed[name] = true
+	}
+
+	return installed
+}
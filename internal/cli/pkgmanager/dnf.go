@@ -0,0 +1,10 @@
+package pkgmanager
+
+func dnfBackend() backend {
+	return backend{
+		name:    "dnf",
+		list:    []string{"rpm", "--query", "--all", "--queryformat", "%{NAME}\n"},
+		parse:   parseLines,
+		install: []string{"dnf", "install", "--assumeyes"},
+	}
+}
@@ -0,0 +1,10 @@
+package pkgmanager
+
+func pacmanBackend() backend {
+	return backend{
+		name:    "pacman",
+		list:    []string{"pacman", "--query", "--quiet"},
+		parse:   parseLines,
+		install: []string{"pacman", "--sync", "--needed", "--noconfirm"},
+	}
+}
@@ -0,0 +1,258 @@
+package pkgmanager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+)
+
+// PackageFailure records why a single package could not be installed.
+type PackageFailure struct {
+	Package string
+	Err     error
+}
+
+// InstallError is returned by batch operations so that failures can be
+// attributed to the individual packages that caused them.
+type InstallError struct {
+	Manager  string
+	Failures []PackageFailure
+}
+
+func (e *InstallError) Error() string {
+	msg := fmt.Sprintf("Failed to install %d %s package(s):", len(e.Failures), e.Manager)
+	for _, failure := range e.Failures {
+		msg = fmt.Sprintf("%s\n  %s: %s", msg, failure.Package, failure.Err)
+	}
+
+	return msg
+}
+
+// Client is a distribution package manager. Installed packages are read once
+// and cached until an install changes them, like homebrew.Client.
+type Client interface {
+	Name() string
+	IsInstalled(pkg string) bool
+	InstallAll(pkgs []string) error
+	EnsureInstalledAll(pkgs []string) error
+}
+
+// backend describes how to drive a specific package manager.
+type backend struct {
+	name string
+	// list prints the installed packages; parse turns its output into names.
+	list  []string
+	parse func(out string) map[string]bool
+	// update refreshes the package index, once before the first install.
+	// Fresh images such as Debian's have none.
+	update []string
+	// install is followed by the package names.
+	install []string
+	env     []string
+}
+
+type client struct {
+	backend   backend
+	cmdGen    cmdexec.EnvCmdGenerator
+	sudo      bool
+	updated   bool
+	installed map[string]bool
+}
+
+// New returns the package manager of the current Linux distribution.
+func New() (Client, error) {
+	sys := systeminfo.New()
+
+	distro, err := sys.Distro()
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := backendFor(distro)
+	if err != nil {
+		return nil, err
+	}
+
+	return newClientWithComponents(b, cmdexec.NewEnvCommandGenerator(), !sys.IsSudo() && !sys.IsRoot()), nil
+}
+
+func newClientWithComponents(b backend, gen cmdexec.EnvCmdGenerator, sudo bool) *client {
+	return &client{
+		backend: b,
+		cmdGen:  gen,
+		sudo:    sudo,
+	}
+}
+
+func backendFor(distro *systeminfo.Distro) (backend, error) {
+	switch {
+	case distro.Is("debian", "ubuntu"):
+		return aptBackend(), nil
+	case distro.Is("fedora", "rhel", "centos"):
+		return dnfBackend(), nil
+	case distro.Is("arch"):
+		return pacmanBackend(), nil
+	}
+
+	return backend{}, errors.Errorf("No supported package manager for distribution %s", distro.ID)
+}
+
+func (c *client) Name() string {
+	return c.backend.name
+}
+
+func (c *client) EnsureInstalledAll(pkgs []string) error {
+	missing := []string{}
+	for _, pkg := range pkgs {
+		if c.IsInstalled(pkg) {
+			log.Infof("%s package %s is already installed", c.Name(), pkg)
+			continue
+		}
+
+		missing = append(missing, pkg)
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	return c.InstallAll(missing)
+}
+
+func (c *client) IsInstalled(pkg string) bool {
+	if pkg == "" {
+		return false
+	}
+
+	installed, err := c.installedPackages()
+	if err != nil {
+		log.Debugf("Unable to list installed %s packages: %s", c.Name(), err)
+		return false
+	}
+
+	return installed[pkg]
+}
+
+// InstallAll installs all packages with a single invocation. When it fails,
+// the packages that are still missing are retried one by one so the returned
+// InstallError points at the actual culprits.
+func (c *client) InstallAll(pkgs []string) error {
+	for _, pkg := range pkgs {
+		if pkg == "" {
+			return errors.Errorf("Package name is required")
+		}
+	}
+
+	if len(pkgs) == 0 {
+		return nil
+	}
+
+	if err := c.update(); err != nil {
+		return err
+	}
+
+	log.Infof("Installing %s package(s) %s", c.Name(), strings.Join(pkgs, ", "))
+
+	err := c.install(pkgs...)
+	c.installed = nil
+
+	if err == nil {
+		return nil
+	}
+
+	installErr := &InstallError{Manager: c.Name()}
+
+	if len(pkgs) == 1 {
+		installErr.Failures = append(installErr.Failures, PackageFailure{Package: pkgs[0], Err: err})
+		return installErr
+	}
+
+	log.Debugf("Batch %s install failed, retrying missing packages individually: %s", c.Name(), err)
+
+	for _, pkg := range pkgs {
+		if c.IsInstalled(pkg) {
+			continue
+		}
+
+		if err := c.install(pkg); err != nil {
+			installErr.Failures = append(installErr.Failures, PackageFailure{Package: pkg, Err: err})
+		}
+		c.installed = nil
+	}
+
+	if len(installErr.Failures) > 0 {
+		return installErr
+	}
+
+	return nil
+}
+
+func (c *client) installedPackages() (map[string]bool, error) {
+	if c.installed != nil {
+		return c.installed, nil
+	}
+
+	log.Debugf("Loading installed %s packages", c.Name())
+
+	cmd := c.cmdGen(c.backend.list[0], c.backend.list[1:], c.backend.env)
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Errorf("%s failed: err: %s stderr: %s", strings.Join(c.backend.list, " "), err, cmd.Stderr())
+	}
+
+	c.installed = c.backend.parse(cmd.Stdout())
+
+	return c.installed, nil
+}
+
+func (c *client) update() error {
+	if c.updated || len(c.backend.update) == 0 {
+		return nil
+	}
+
+	log.Infof("Updating the %s package index", c.Name())
+	if err := c.runAsRoot(c.backend.update); err != nil {
+		return err
+	}
+
+	c.updated = true
+	return nil
+}
+
+func (c *client) install(pkgs ...string) error {
+	args := append([]string{}, c.backend.install...)
+	return c.runAsRoot(append(args, pkgs...))
+}
+
+// runAsRoot runs command, with sudo unless gum runs as root. sudo resets the
+// environment, so the backend env is passed as sudo arguments.
+func (c *client) runAsRoot(command []string) error {
+	name, args, env := command[0], command[1:], c.backend.env
+	if c.sudo {
+		name = "sudo"
+		args = append(append([]string{}, c.backend.env...), command...)
+		env = nil
+	}
+
+	cmd := c.cmdGen(name, args, env)
+	if err := cmd.Run(); err != nil {
+		return errors.Errorf("%s %s failed: err: %s stdout: %s stderr: %s", name, strings.Join(args, " "), err, cmd.Stdout(), cmd.Stderr())
+	}
+
+	return nil
+}
+
+func parseLines(out string) map[string]bool {
+	installed := map[string]bool{}
+
+	for _, line := range strings.Split(out, "\n") {
+		if name := strings.TrimSpace(line); name != "" {
+			installed[name] = true
+		}
+	}
+
+	return installed
+}
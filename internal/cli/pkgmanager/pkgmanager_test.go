@@ -0,0 +1,136 @@
+package pkgmanager
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec/fakecmdexec"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+	"github.com/stretchr/testify/suite"
+)
+
+type pkgManagerSuite struct {
+	suite.Suite
+}
+
+func (s *pkgManagerSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *pkgManagerSuite) TestBackendFor() {
+	tests := []struct {
+		distro   systeminfo.Distro
+		expected string
+	}{
+		{distro: systeminfo.Distro{ID: "ubuntu", IDLike: []string{"debian"}}, expected: "apt"},
+		{distro: systeminfo.Distro{ID: "debian"}, expected: "apt"},
+		{distro: systeminfo.Distro{ID: "fedora"}, expected: "dnf"},
+		{distro: systeminfo.Distro{ID: "rocky", IDLike: []string{"rhel", "centos", "fedora"}}, expected: "dnf"},
+		{distro: systeminfo.Distro{ID: "manjaro", IDLike: []string{"arch"}}, expected: "pacman"},
+	}
+
+	for _, t := range tests {
+		s.Run(t.distro.ID, func() {
+			b, err := backendFor(&t.distro)
+			s.Require().NoError(err)
+			s.Require().Equal(t.expected, b.name)
+		})
+	}
+
+	_, err := backendFor(&systeminfo.Distro{ID: "alpine"})
+	s.Require().ErrorContains(err, "No supported package manager for distribution alpine")
+}
+
+func (s *pkgManagerSuite) TestParseDpkgQuery() {
+	installed := parseDpkgQuery("build-essential\tii \nlibpq-dev:amd64\tii \nvim\trc \n")
+
+	s.Require().Equal(map[string]bool{"build-essential": true, "libpq-dev": true}, installed)
+}
+
+func (s *pkgManagerSuite) TestEnsureInstalledAllBatchesMissingPackages() {
+	listCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: "build-essential\tii \ncurl\tii \n",
+	})
+	updateCmd := fakecmdexec.NewNoOpCommand()
+	installCmd := fakecmdexec.NewNoOpCommand()
+	c := newClientWithComponents(aptBackend(), fakecmdexec.NewEnvCmdGenerator(listCmd, updateCmd, installCmd), true)
+
+	err := c.EnsureInstalledAll([]string{"build-essential", "libpq-dev", "libyaml-dev"})
+
+	s.Require().NoError(err)
+	s.Require().Equal("dpkg-query", listCmd.Cmd())
+	s.Require().Equal("sudo", updateCmd.Cmd())
+	s.Require().Equal([]string{"DEBIAN_FRONTEND=noninteractive", "apt-get", "update"}, updateCmd.Args())
+	s.Require().Equal("sudo", installCmd.Cmd())
+	s.Require().Equal([]string{"DEBIAN_FRONTEND=noninteractive", "apt-get", "install", "--yes", "--no-install-recommends", "libpq-dev", "libyaml-dev"}, installCmd.Args())
+	s.Require().Empty(installCmd.Env())
+}
+
+func (s *pkgManagerSuite) TestInstallAllUpdatesAptOnceAsRoot() {
+	updateCmd := fakecmdexec.NewNoOpCommand()
+	installCmd := fakecmdexec.NewNoOpCommand()
+	secondInstallCmd := fakecmdexec.NewNoOpCommand()
+	c := newClientWithComponents(aptBackend(), fakecmdexec.NewEnvCmdGenerator(updateCmd, installCmd, secondInstallCmd), false)
+
+	s.Require().NoError(c.InstallAll([]string{"libpq-dev"}))
+	s.Require().NoError(c.InstallAll([]string{"libyaml-dev"}))
+
+	s.Require().Equal("apt-get", updateCmd.Cmd())
+	s.Require().Equal([]string{"update"}, updateCmd.Args())
+	s.Require().Equal([]string{"DEBIAN_FRONTEND=noninteractive"}, updateCmd.Env())
+	s.Require().Equal("apt-get", secondInstallCmd.Cmd())
+	s.Require().Equal([]string{"install", "--yes", "--no-install-recommends", "libyaml-dev"}, secondInstallCmd.Args())
+	s.Require().Equal([]string{"DEBIAN_FRONTEND=noninteractive"}, secondInstallCmd.Env())
+}
+
+func (s *pkgManagerSuite) TestEnsureInstalledAllNothingMissing() {
+	listCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: "gcc\nlibpq-devel\n",
+	})
+	c := newClientWithComponents(dnfBackend(), fakecmdexec.NewEnvCmdGenerator(listCmd), true)
+
+	err := c.EnsureInstalledAll([]string{"gcc", "libpq-devel"})
+
+	s.Require().NoError(err)
+	s.Require().Equal("rpm", listCmd.Cmd())
+}
+
+func (s *pkgManagerSuite) TestInstallAllWithoutSudo() {
+	installCmd := fakecmdexec.NewNoOpCommand()
+	c := newClientWithComponents(pacmanBackend(), fakecmdexec.NewEnvCmdGenerator(installCmd), false)
+
+	err := c.InstallAll([]string{"base-devel", "postgresql-libs"})
+
+	s.Require().NoError(err)
+	s.Require().Equal("pacman", installCmd.Cmd())
+	s.Require().Equal([]string{"--sync", "--needed", "--noconfirm", "base-devel", "postgresql-libs"}, installCmd.Args())
+}
+
+func (s *pkgManagerSuite) TestInstallAllAttributesFailures() {
+	batchCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Err: errors.New("exit status 1"),
+	})
+	listCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: "gcc\n",
+	})
+	retryCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stderr: "Error: Unable to find a match: doesnotexist",
+		Err:    errors.New("exit status 1"),
+	})
+	c := newClientWithComponents(dnfBackend(), fakecmdexec.NewEnvCmdGenerator(batchCmd, listCmd, retryCmd), false)
+
+	err := c.InstallAll([]string{"gcc", "doesnotexist"})
+
+	installErr := &InstallError{}
+	s.Require().ErrorAs(err, &installErr)
+	s.Require().Len(installErr.Failures, 1)
+	s.Require().Equal("doesnotexist", installErr.Failures[0].Package)
+	s.Require().ErrorContains(installErr.Failures[0].Err, "Unable to find a match")
+	s.Require().Equal([]string{"install", "--assumeyes", "doesnotexist"}, retryCmd.Args())
+}
+
+func TestPkgManagerSuite(t *testing.T) {
+	suite.Run(t, new(pkgManagerSuite))
+}
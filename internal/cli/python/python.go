@@ -0,0 +1,329 @@
+package python
+
+import (
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
+	"github.com/renegumroad/gum-cli/internal/cli/homebrew"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/lockhash"
+	"github.com/renegumroad/gum-cli/internal/log"
+)
+
+var (
+	Uv    = "uv"
+	Pyenv = "pyenv"
+
+	UvLock        = "uv.lock"
+	PoetryLock    = "poetry.lock"
+	Requirements  = "requirements.txt"
+	virtualenvDir = ".venv"
+
+	// dependencyFiles are checked in order, the first one found decides how
+	// the dependencies are installed.
+	dependencyFiles = []string{UvLock, PoetryLock, Requirements}
+
+	// hashFile records the hash of the dependency file of the last
+	// successful install. It lives in the virtualenv so that recreating it
+	// also invalidates it.
+	hashFile = filepath.Join(virtualenvDir, ".gum-lockfile-hash")
+)
+
+// Client installs python with pyenv or uv and the project dependencies into
+// a virtualenv in the .venv directory.
+type Client interface {
+	Manager() string
+	// Package is the brew package providing the manager.
+	Package() homebrew.Package
+	IsPythonInstalled(version string) bool
+	InstallPython(version string) error
+	HasVirtualenv() bool
+	VirtualenvVersion() (string, error)
+	CreateVirtualenv(version string) error
+	DependencyFile() (string, error)
+	IsInstallCurrent() bool
+	InstallDependencies() error
+}
+
+type client struct {
+	manager string
+	fs      filesystem.Client
+	cmdGen  cmdexec.EnvCmdGenerator
+}
+
+// New returns a client using uv for projects with a uv.lock, and otherwise
+// whichever of uv or pyenv is found in the PATH, uv first.
+func New() Client {
+	fs := filesystem.New()
+
+	return newClientWithComponents(
+		resolveManager(fs, exec.LookPath),
+		fs,
+		cmdexec.NewEnvCommandGenerator(),
+	)
+}
+
+func newClientWithComponents(
+	manager string,
+	fs filesystem.Client,
+	cmdGen cmdexec.EnvCmdGenerator,
+) *client {
+	return &client{
+		manager: manager,
+		fs:      fs,
+		cmdGen:  cmdGen,
+	}
+}
+
+func resolveManager(fs filesystem.Client, lookPath func(file string) (string, error)) string {
+	if dir, err := fs.CurrentDir(); err == nil && fs.Exists(filepath.Join(dir, UvLock)) {
+		return Uv
+	}
+
+	for _, name := range []string{Uv, Pyenv} {
+		if _, err := lookPath(name); err == nil {
+			log.Debugf("Detected python manager %s", name)
+			return name
+		}
+	}
+
+	log.Debugf("No python manager detected, defaulting to %s", Uv)
+	return Uv
+}
+
+func (c *client) Manager() string {
+	return c.manager
+}
+
+func (c *client) Package() homebrew.Package {
+	return homebrew.Package{Name: c.manager}
+}
+
+func (c *client) IsPythonInstalled(version string) bool {
+	if c.manager == Uv {
+		// uv python find fails when no installed interpreter matches
+		cmd := c.cmdGen("uv", []string{"python", "find", version}, []string{})
+		return cmd.Run() == nil
+	}
+
+	cmd := c.cmdGen("pyenv", []string{"versions", "--bare"}, []string{})
+	if err := cmd.Run(); err != nil {
+		log.Debugf("Failed to list pyenv versions: %s", err)
+		return false
+	}
+
+	return slices.ContainsFunc(strings.Fields(cmd.Stdout()), func(installed string) bool {
+		return installed == version || strings.HasPrefix(installed, version+".")
+	})
+}
+
+func (c *client) InstallPython(version string) error {
+	log.Infof("Installing python %s with %s", version, c.manager)
+
+	args := []string{"install", "--skip-existing", version}
+	if c.manager == Uv {
+		args = []string{"python", "install", version}
+	}
+
+	cmd := c.cmdGen(c.manager, args, []string{})
+	if err := cmd.Run(); err != nil {
+		return errors.Errorf("Failed python %s installation: %s %s", version, err, cmd.Stderr())
+	}
+
+	return nil
+}
+
+func (c *client) HasVirtualenv() bool {
+	path, err := c.path(virtualenvDir, "pyvenv.cfg")
+	if err != nil {
+		log.Debugf("Unable to check for a virtualenv: %s", err)
+		return false
+	}
+
+	return c.fs.Exists(path)
+}
+
+// VirtualenvVersion reads the python version of the virtualenv from its
+// pyvenv.cfg.
+func (c *client) VirtualenvVersion() (string, error) {
+	path, err := c.path(virtualenvDir, "pyvenv.cfg")
+	if err != nil {
+		return "", err
+	}
+
+	content, err := c.fs.ReadString(path)
+	if err != nil {
+		return "", errors.Errorf("Unable to read %s: %s", path, err)
+	}
+
+	for _, line := range strings.Split(content, "\n") {
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			continue
+		}
+
+		// venv writes "version", uv writes "version_info"
+		key = strings.TrimSpace(key)
+		if key == "version" || key == "version_info" {
+			return strings.TrimSpace(value), nil
+		}
+	}
+
+	return "", errors.Errorf("No python version found in %s", path)
+}
+
+// CreateVirtualenv creates the .venv virtualenv, replacing any existing one.
+// An empty version uses the default python of the manager.
+func (c *client) CreateVirtualenv(version string) error {
+	dir, err := c.fs.CurrentDir()
+	if err != nil {
+		return err
+	}
+
+	venv := filepath.Join(dir, virtualenvDir)
+
+	var cmd cmdexec.Command
+	if c.manager == Uv {
+		args := []string{"venv", "--clear"}
+		if version != "" {
+			args = append(args, "--python", version)
+		}
+
+		cmd = c.cmdGen("uv", append(args, venv), []string{})
+	} else {
+		interpreter, err := c.pyenvInterpreter(version)
+		if err != nil {
+			return err
+		}
+
+		cmd = c.cmdGen(interpreter, []string{"-m", "venv", "--clear", venv}, []string{})
+	}
+
+	log.Infof("Creating virtualenv in %s", venv)
+
+	if err := cmd.Run(); err != nil {
+		return errors.Errorf("Failed to create virtualenv: %s %s", err, cmd.Stderr())
+	}
+
+	return nil
+}
+
+func (c *client) pyenvInterpreter(version string) (string, error) {
+	if version == "" {
+		return "python3", nil
+	}
+
+	cmd := c.cmdGen("pyenv", []string{"prefix", version}, []string{})
+	if err := cmd.Run(); err != nil {
+		return "", errors.Errorf("Unable to find python %s with pyenv: %s %s", version, err, cmd.Stderr())
+	}
+
+	return filepath.Join(strings.TrimSpace(cmd.Stdout()), "bin", "python"), nil
+}
+
+// DependencyFile returns the lockfile or requirements file of the project
+// or an empty string when there is none.
+func (c *client) DependencyFile() (string, error) {
+	dir, err := c.fs.CurrentDir()
+	if err != nil {
+		return "", err
+	}
+
+	for _, file := range dependencyFiles {
+		if c.fs.Exists(filepath.Join(dir, file)) {
+			return file, nil
+		}
+	}
+
+	return "", nil
+}
+
+// IsInstallCurrent reports whether the dependencies were installed from the
+// current dependency file.
+func (c *client) IsInstallCurrent() bool {
+	file, err := c.DependencyFile()
+	if err != nil {
+		log.Debugf("Unable to check the installed dependencies: %s", err)
+		return false
+	}
+
+	if file == "" {
+		return true
+	}
+
+	dir, err := c.fs.CurrentDir()
+	if err != nil {
+		log.Debugf("Unable to check the installed dependencies: %s", err)
+		return false
+	}
+
+	return lockhash.IsCurrent(c.fs, filepath.Join(dir, file), filepath.Join(dir, hashFile))
+}
+
+// InstallDependencies installs the dependencies into the virtualenv with
+// uv sync, poetry install or pip, and records the dependency file hash.
+func (c *client) InstallDependencies() error {
+	file, err := c.DependencyFile()
+	if err != nil {
+		return err
+	}
+
+	if file == "" {
+		log.Infof("No python dependencies declared, skipping installation")
+		return nil
+	}
+
+	venv, err := c.path(virtualenvDir)
+	if err != nil {
+		return err
+	}
+
+	// poetry and uv install into the active virtualenv
+	env := []string{"VIRTUAL_ENV=" + venv}
+
+	var cmd cmdexec.Command
+	switch {
+	case file == UvLock:
+		cmd = c.cmdGen("uv", []string{"sync", "--frozen"}, env)
+	case file == PoetryLock:
+		cmd = c.cmdGen("poetry", []string{"install", "--no-interaction"}, env)
+	case c.manager == Uv:
+		cmd = c.cmdGen("uv", []string{"pip", "install", "-r", Requirements}, env)
+	default:
+		cmd = c.cmdGen(filepath.Join(venv, "bin", "python"), []string{"-m", "pip", "install", "-r", Requirements}, env)
+	}
+
+	log.Infof("Installing python dependencies from %s", file)
+
+	if err := cmd.Run(); err != nil {
+		return errors.Errorf("Failed to install python dependencies: err: %s; stdout: %s; stderr: %s", err, cmd.Stdout(), cmd.Stderr())
+	}
+
+	return c.recordInstall(file)
+}
+
+func (c *client) recordInstall(file string) error {
+	dir, err := c.fs.CurrentDir()
+	if err != nil {
+		return err
+	}
+
+	if err := lockhash.Record(c.fs, filepath.Join(dir, file), filepath.Join(dir, hashFile)); err != nil {
+		return errors.Errorf("Failed to record python dependencies installation: %s", err)
+	}
+
+	return nil
+}
+
+func (c *client) path(elem ...string) (string, error) {
+	dir, err := c.fs.CurrentDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(append([]string{dir}, elem...)...), nil
+}
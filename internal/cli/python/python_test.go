@@ -0,0 +1,194 @@
+package python
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec/fakecmdexec"
+	"github.com/renegumroad/gum-cli/internal/filesystem/mockfilesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type pythonSuite struct {
+	suite.Suite
+	mockFs *mockfilesystem.MockClient
+}
+
+func (s *pythonSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *pythonSuite) SetupTest() {
+	s.mockFs = mockfilesystem.NewMockClient(s.T())
+	s.mockFs.EXPECT().CurrentDir().Return("/app", nil).Maybe()
+}
+
+func (s *pythonSuite) withFiles(files ...string) {
+	present := map[string]bool{}
+	for _, file := range files {
+		present["/app/"+file] = true
+	}
+
+	s.mockFs.EXPECT().Exists(mock.Anything).RunAndReturn(func(path string) bool {
+		return present[path]
+	}).Maybe()
+}
+
+func hashOf(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func lookPathFor(available ...string) func(string) (string, error) {
+	return func(file string) (string, error) {
+		for _, name := range available {
+			if name == file {
+				return "/usr/local/bin/" + file, nil
+			}
+		}
+		return "", errors.New("executable file not found in $PATH")
+	}
+}
+
+func (s *pythonSuite) TestResolveManager() {
+	s.withFiles()
+
+	s.Require().Equal(Pyenv, resolveManager(s.mockFs, lookPathFor("pyenv")))
+	s.Require().Equal(Uv, resolveManager(s.mockFs, lookPathFor("pyenv", "uv")))
+	s.Require().Equal(Uv, resolveManager(s.mockFs, lookPathFor()))
+}
+
+func (s *pythonSuite) TestResolveManagerFromUvLock() {
+	s.withFiles("uv.lock")
+
+	s.Require().Equal(Uv, resolveManager(s.mockFs, lookPathFor("pyenv")))
+}
+
+func (s *pythonSuite) TestPyenvIsPythonInstalled() {
+	listCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: "3.11.9\n3.12.4\n",
+	})
+	c := newClientWithComponents(Pyenv, s.mockFs, fakecmdexec.NewEnvCmdGenerator(listCmd))
+
+	s.Require().True(c.IsPythonInstalled("3.12"))
+	s.Require().Equal([]string{"versions", "--bare"}, listCmd.Args())
+}
+
+func (s *pythonSuite) TestUvInstallPython() {
+	installCmd := fakecmdexec.NewNoOpCommand()
+	c := newClientWithComponents(Uv, s.mockFs, fakecmdexec.NewEnvCmdGenerator(installCmd))
+
+	s.Require().NoError(c.InstallPython("3.12.4"))
+	s.Require().Equal("uv", installCmd.Cmd())
+	s.Require().Equal([]string{"python", "install", "3.12.4"}, installCmd.Args())
+}
+
+func (s *pythonSuite) TestVirtualenvVersion() {
+	s.mockFs.EXPECT().ReadString("/app/.venv/pyvenv.cfg").Return("home = /usr/bin\nimplementation = CPython\nversion_info = 3.12.4\n", nil)
+	c := newClientWithComponents(Uv, s.mockFs, nil)
+
+	version, err := c.VirtualenvVersion()
+
+	s.Require().NoError(err)
+	s.Require().Equal("3.12.4", version)
+}
+
+func (s *pythonSuite) TestPyenvCreateVirtualenv() {
+	prefixCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: "/home/dev/.pyenv/versions/3.12.4\n",
+	})
+	venvCmd := fakecmdexec.NewNoOpCommand()
+	c := newClientWithComponents(Pyenv, s.mockFs, fakecmdexec.NewEnvCmdGenerator(prefixCmd, venvCmd))
+
+	s.Require().NoError(c.CreateVirtualenv("3.12.4"))
+	s.Require().Equal([]string{"prefix", "3.12.4"}, prefixCmd.Args())
+	s.Require().Equal("/home/dev/.pyenv/versions/3.12.4/bin/python", venvCmd.Cmd())
+	s.Require().Equal([]string{"-m", "venv", "--clear", "/app/.venv"}, venvCmd.Args())
+}
+
+func (s *pythonSuite) TestUvCreateVirtualenv() {
+	venvCmd := fakecmdexec.NewNoOpCommand()
+	c := newClientWithComponents(Uv, s.mockFs, fakecmdexec.NewEnvCmdGenerator(venvCmd))
+
+	s.Require().NoError(c.CreateVirtualenv("3.12.4"))
+	s.Require().Equal([]string{"venv", "--clear", "--python", "3.12.4", "/app/.venv"}, venvCmd.Args())
+}
+
+func (s *pythonSuite) TestDependencyFilePrefersLockfiles() {
+	s.withFiles("requirements.txt", "poetry.lock")
+	c := newClientWithComponents(Uv, s.mockFs, nil)
+
+	file, err := c.DependencyFile()
+
+	s.Require().NoError(err)
+	s.Require().Equal(PoetryLock, file)
+}
+
+func (s *pythonSuite) TestIsInstallCurrent() {
+	s.withFiles("uv.lock", ".venv/.gum-lockfile-hash")
+	s.mockFs.EXPECT().ReadString("/app/.venv/.gum-lockfile-hash").Return(hashOf("version = 1\n"), nil)
+	s.mockFs.EXPECT().ReadString("/app/uv.lock").Return("version = 1\n", nil)
+	c := newClientWithComponents(Uv, s.mockFs, nil)
+
+	s.Require().True(c.IsInstallCurrent())
+}
+
+func (s *pythonSuite) TestIsInstallCurrentLockfileChanged() {
+	s.withFiles("uv.lock", ".venv/.gum-lockfile-hash")
+	s.mockFs.EXPECT().ReadString("/app/.venv/.gum-lockfile-hash").Return(hashOf("version = 0\n"), nil)
+	s.mockFs.EXPECT().ReadString("/app/uv.lock").Return("version = 1\n", nil)
+	c := newClientWithComponents(Uv, s.mockFs, nil)
+
+	s.Require().False(c.IsInstallCurrent())
+}
+
+func (s *pythonSuite) TestInstallDependenciesWithPoetry() {
+	s.withFiles("poetry.lock")
+	s.mockFs.EXPECT().ReadString("/app/poetry.lock").Return("[[package]]\n", nil)
+	s.mockFs.EXPECT().MkdirAll("/app/.venv").Return(nil)
+	s.mockFs.EXPECT().WriteString("/app/.venv/.gum-lockfile-hash", hashOf("[[package]]\n")).Return(nil)
+	installCmd := fakecmdexec.NewNoOpCommand()
+	c := newClientWithComponents(Pyenv, s.mockFs, fakecmdexec.NewEnvCmdGenerator(installCmd))
+
+	s.Require().NoError(c.InstallDependencies())
+	s.Require().Equal("poetry", installCmd.Cmd())
+	s.Require().Equal([]string{"install", "--no-interaction"}, installCmd.Args())
+	s.Require().Equal([]string{"VIRTUAL_ENV=/app/.venv"}, installCmd.Env())
+}
+
+func (s *pythonSuite) TestInstallDependenciesWithPip() {
+	s.withFiles("requirements.txt")
+	s.mockFs.EXPECT().ReadString("/app/requirements.txt").Return("requests==2.32.3\n", nil)
+	s.mockFs.EXPECT().MkdirAll("/app/.venv").Return(nil)
+	s.mockFs.EXPECT().WriteString("/app/.venv/.gum-lockfile-hash", hashOf("requests==2.32.3\n")).Return(nil)
+	installCmd := fakecmdexec.NewNoOpCommand()
+	c := newClientWithComponents(Pyenv, s.mockFs, fakecmdexec.NewEnvCmdGenerator(installCmd))
+
+	s.Require().NoError(c.InstallDependencies())
+	s.Require().Equal("/app/.venv/bin/python", installCmd.Cmd())
+	s.Require().Equal([]string{"-m", "pip", "install", "-r", "requirements.txt"}, installCmd.Args())
+}
+
+func (s *pythonSuite) TestInstallDependenciesFailure() {
+	s.withFiles("uv.lock")
+	installCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stderr: "The lockfile needs to be updated",
+		Err:    errors.New("exit status 2"),
+	})
+	c := newClientWithComponents(Uv, s.mockFs, fakecmdexec.NewEnvCmdGenerator(installCmd))
+
+	err := c.InstallDependencies()
+
+	s.Require().ErrorContains(err, "The lockfile needs to be updated")
+	s.Require().Equal([]string{"sync", "--frozen"}, installCmd.Args())
+	s.mockFs.AssertNotCalled(s.T(), "WriteString", mock.Anything, mock.Anything)
+}
+
+func TestPythonSuite(t *testing.T) {
+	suite.Run(t, new(pythonSuite))
+}
@@ -10,8 +10,8 @@ import (
 )
 
 type Client interface {
-	IsRubyInstalled() bool
-	EnsureRubyInstalled() error
+	InstallVersion(version string) error
+	InstalledVersions() ([]string, error)
 }
 
 type client struct {
@@ -22,7 +22,7 @@ type client struct {
 func New() Client {
 	return newClientWithComponents(
 		cmdexec.NewCommandGenerator(),
-		homebrew.New(),
+		homebrew.Default(),
 	)
 }
 
@@ -36,39 +36,41 @@ func newClientWithComponents(
 	}
 }
 
-func (c *client) EnsureRubyInstalled() error {
-	if c.IsRubyInstalled() {
-		log.Infof("Ruby version is already installed")
-		return nil
+// InstallVersion installs a ruby version with rbenv, after updating
+// ruby-build so that recent versions are known.
+func (c *client) InstallVersion(version string) error {
+	if version == "" {
+		return errors.Errorf("Ruby version is required")
 	}
 
 	if err := c.updateRubyBuild(); err != nil {
 		return err
 	}
 
-	log.Infof("Installing ruby version")
+	log.Infof("Installing ruby %s", version)
 
-	cmd := c.cmdGen("rbenv", "install", "--skip-existing")
-	err := cmd.Run()
-
-	if err != nil {
-		return errors.Errorf("Failed ruby installation: %s", err)
+	cmd := c.cmdGen("rbenv", "install", "--skip-existing", version)
+	if err := cmd.Run(); err != nil {
+		return errors.Errorf("Failed ruby %s installation: %s", version, err)
 	}
 
 	return nil
 }
 
-func (c *client) IsRubyInstalled() bool {
-	log.Debugln("Checking ruby version")
+func (c *client) InstalledVersions() ([]string, error) {
+	cmd := c.cmdGen("rbenv", "versions", "--bare")
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Errorf("Failed to list ruby versions: %s", err)
+	}
 
-	cmd := c.cmdGen("rbenv", "version")
-	err := cmd.Run()
-	if err != nil {
-		log.Debugf("Failed to check ruby version: %s", err)
-		return false
+	versions := []string{}
+	for _, line := range strings.Split(cmd.Stdout(), "\n") {
+		if version := strings.TrimSpace(line); version != "" {
+			versions = append(versions, version)
+		}
 	}
 
-	return !strings.Contains(cmd.Stdout(), "not installed")
+	return versions, nil
 }
 
 func (c *client) updateRubyBuild() error {
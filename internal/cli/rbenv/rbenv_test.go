@@ -25,75 +25,41 @@ func (s *rbenvSuite) SetupTest() {
 	s.mockBrew = mockhomebrew.NewMockClient(s.T())
 }
 
-// TestIsRubyInstalled_Success
-func (s *rbenvSuite) TestIsRubyInstalledSuccess() {
-	rbenvCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
-		Stdout: "2.7.2 (set by path)",
+func (s *rbenvSuite) TestInstalledVersions() {
+	versionsCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: "3.2.2\n3.3.4\n",
 	})
-	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(rbenvCmd), s.mockBrew)
+	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(versionsCmd), s.mockBrew)
 
-	result := client.IsRubyInstalled()
+	versions, err := client.InstalledVersions()
 
-	s.Require().True(result)
-	s.Require().Equal("rbenv", rbenvCmd.Cmd())
-	s.Require().Equal([]string{"version"}, rbenvCmd.Args())
-}
-
-func (s *rbenvSuite) TestIsRubyInstalledNotInstalled() {
-	rbenvCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
-		Stdout: "rbenv: version '2.7.2' is not installed (set by path)",
-	})
-	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(rbenvCmd), s.mockBrew)
-
-	result := client.IsRubyInstalled()
-
-	s.Require().False(result)
-	s.Require().Equal("rbenv", rbenvCmd.Cmd())
-	s.Require().Equal([]string{"version"}, rbenvCmd.Args())
-}
-
-func (s *rbenvSuite) TestIsRubyInstalleErrorRunningCommand() {
-	rbenvCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
-		Err: errors.New("error running command"),
-	})
-	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(rbenvCmd), s.mockBrew)
-
-	result := client.IsRubyInstalled()
-
-	s.Require().False(result)
-	s.Require().Equal("rbenv", rbenvCmd.Cmd())
-	s.Require().Equal([]string{"version"}, rbenvCmd.Args())
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"3.2.2", "3.3.4"}, versions)
+	s.Require().Equal("rbenv", versionsCmd.Cmd())
+	s.Require().Equal([]string{"versions", "--bare"}, versionsCmd.Args())
 }
 
-func (s *rbenvSuite) TestEnsureRubyInstalledAlreadyInstalled() {
-	rbenvCmdVersion := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
-		Stdout: "2.7.2 (set by path)",
+func (s *rbenvSuite) TestInstalledVersionsRbenvMissing() {
+	versionsCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Err: errors.New("rbenv: command not found"),
 	})
-	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(rbenvCmdVersion), s.mockBrew)
+	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(versionsCmd), s.mockBrew)
 
-	err := client.EnsureRubyInstalled()
+	_, err := client.InstalledVersions()
 
-	s.Require().NoError(err)
-	s.Require().Equal("rbenv", rbenvCmdVersion.Cmd())
-	s.Require().Equal([]string{"version"}, rbenvCmdVersion.Args())
+	s.Require().ErrorContains(err, "Failed to list ruby versions")
 }
 
-func (s *rbenvSuite) TestEnsureRubyInstalledNotInstalled() {
-	rbenvCmdVersion := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
-		Stdout: "2.7.2 not installed (set by path)",
-	})
-	rbenvInstallCmd := fakecmdexec.NewNoOpCommand()
+func (s *rbenvSuite) TestInstallVersion() {
+	installCmd := fakecmdexec.NewNoOpCommand()
 	s.mockBrew.EXPECT().Upgrade(homebrew.Package{Name: "ruby-build"}).Return(nil)
-	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(rbenvCmdVersion, rbenvInstallCmd), s.mockBrew)
+	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(installCmd), s.mockBrew)
 
-	err := client.EnsureRubyInstalled()
+	err := client.InstallVersion("3.3.4")
 
 	s.Require().NoError(err)
-	s.Require().Equal("rbenv", rbenvCmdVersion.Cmd())
-	s.Require().Equal([]string{"version"}, rbenvCmdVersion.Args())
-	s.Require().Equal("rbenv", rbenvInstallCmd.Cmd())
-	s.Require().Equal([]string{"install", "--skip-existing"}, rbenvInstallCmd.Args())
-	s.mockBrew.AssertExpectations(s.T())
+	s.Require().Equal("rbenv", installCmd.Cmd())
+	s.Require().Equal([]string{"install", "--skip-existing", "3.3.4"}, installCmd.Args())
 }
 
 func TestRbenvSuite(t *testing.T) {
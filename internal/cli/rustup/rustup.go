@@ -0,0 +1,198 @@
+package rustup
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
+	"github.com/renegumroad/gum-cli/internal/log"
+)
+
+// Show is the state reported by `rustup show`. Targets are the installed
+// targets of the active toolchain.
+type Show struct {
+	Host       string
+	Toolchains []string
+	Default    string
+	Active     string
+	Targets    []string
+}
+
+// HasToolchain reports whether the channel is installed for the host.
+func (s *Show) HasToolchain(channel string) bool {
+	for _, toolchain := range s.Toolchains {
+		if toolchain == channel || toolchain == channel+"-"+s.Host {
+			return true
+		}
+	}
+
+	return false
+}
+
+type Client interface {
+	Show() (*Show, error)
+	InstalledComponents(toolchain string) ([]string, error)
+	InstallToolchain(toolchain *Toolchain) error
+	AddComponents(toolchain string, components []string) error
+	AddTargets(toolchain string, targets []string) error
+	SetDefault(toolchain string) error
+	IsFetched() bool
+	Fetch() error
+}
+
+type client struct {
+	cmdGen cmdexec.CmdGenerator
+}
+
+func New() Client {
+	return newClientWithComponents(cmdexec.NewCommandGenerator())
+}
+
+func newClientWithComponents(gen cmdexec.CmdGenerator) *client {
+	return &client{
+		cmdGen: gen,
+	}
+}
+
+func (c *client) Show() (*Show, error) {
+	out, err := c.run("rustup", "show")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseShow(out), nil
+}
+
+// parseShow reads the sections of `rustup show`, in the layout of rustup
+// 1.28 and in the older one.
+func parseShow(out string) *Show {
+	show := &Show{
+		Toolchains: []string{},
+		Targets:    []string{},
+	}
+
+	section := ""
+	inTargets := false
+	lines := strings.Split(out, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+
+		if i+1 < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i+1]), "---") {
+			section = trimmed
+			continue
+		}
+
+		if trimmed == "" || strings.HasPrefix(trimmed, "---") {
+			continue
+		}
+
+		if host, found := strings.CutPrefix(trimmed, "Default host:"); found {
+			show.Host = strings.TrimSpace(host)
+			continue
+		}
+
+		switch section {
+		case "installed toolchains":
+			name, flags, _ := strings.Cut(trimmed, " ")
+			show.Toolchains = append(show.Toolchains, name)
+			if strings.Contains(flags, "default") {
+				show.Default = name
+			}
+			if strings.Contains(flags, "active") {
+				show.Active = name
+			}
+		case "installed targets for active toolchain":
+			show.Targets = append(show.Targets, trimmed)
+		case "active toolchain":
+			switch {
+			case strings.HasPrefix(trimmed, "name:"):
+				show.Active = strings.TrimSpace(strings.TrimPrefix(trimmed, "name:"))
+			case trimmed == "installed targets:":
+				inTargets = true
+			case inTargets && strings.HasPrefix(line, "  "):
+				// rustup 1.28 lists the targets of the active toolchain here
+				show.Targets = append(show.Targets, trimmed)
+			case show.Active == "" && !strings.Contains(trimmed, ":"):
+				name, _, _ := strings.Cut(trimmed, " ")
+				show.Active = name
+			}
+		}
+	}
+
+	return show
+}
+
+func (c *client) InstalledComponents(toolchain string) ([]string, error) {
+	out, err := c.run("rustup", "component", "list", "--installed", "--toolchain", toolchain)
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Fields(out), nil
+}
+
+func (c *client) InstallToolchain(toolchain *Toolchain) error {
+	log.Infof("Installing rust toolchain %s", toolchain.Channel)
+
+	args := []string{"toolchain", "install", toolchain.Channel}
+	if toolchain.Profile != "" {
+		args = append(args, "--profile", toolchain.Profile)
+	}
+	for _, component := range toolchain.Components {
+		args = append(args, "--component", component)
+	}
+	for _, target := range toolchain.Targets {
+		args = append(args, "--target", target)
+	}
+
+	_, err := c.run("rustup", args...)
+	return err
+}
+
+func (c *client) AddComponents(toolchain string, components []string) error {
+	log.Infof("Adding rust components %s to %s", strings.Join(components, ", "), toolchain)
+
+	_, err := c.run("rustup", append([]string{"component", "add", "--toolchain", toolchain}, components...)...)
+	return err
+}
+
+func (c *client) AddTargets(toolchain string, targets []string) error {
+	log.Infof("Adding rust targets %s to %s", strings.Join(targets, ", "), toolchain)
+
+	_, err := c.run("rustup", append([]string{"target", "add", "--toolchain", toolchain}, targets...)...)
+	return err
+}
+
+func (c *client) SetDefault(toolchain string) error {
+	log.Infof("Setting %s as the default rust toolchain", toolchain)
+
+	_, err := c.run("rustup", "default", toolchain)
+	return err
+}
+
+// IsFetched reports whether the dependencies of Cargo.lock are already
+// downloaded, by running a fetch that is not allowed to use the network.
+func (c *client) IsFetched() bool {
+	if _, err := c.run("cargo", "fetch", "--offline"); err != nil {
+		log.Debugf("Cargo dependencies are not fetched: %s", err)
+		return false
+	}
+
+	return true
+}
+
+func (c *client) Fetch() error {
+	log.Infof("Running cargo fetch")
+
+	_, err := c.run("cargo", "fetch")
+	return err
+}
+
+func (c *client) run(name string, args ...string) (string, error) {
+	cmd := c.cmdGen(name, args...)
+	if err := cmd.Run(); err != nil {
+		return "", errors.Errorf("Failed %s %s: %s %s", name, strings.Join(args, " "), err, cmd.Stderr())
+	}
+
+	return cmd.Stdout(), nil
+}
@@ -0,0 +1,166 @@
+package rustup
+
+import (
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec/fakecmdexec"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type rustupSuite struct {
+	suite.Suite
+}
+
+func (s *rustupSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+const legacyShow = `Default host: x86_64-apple-darwin
+rustup home:  /Users/dev/.rustup
+
+installed toolchains
+--------------------
+
+stable-x86_64-apple-darwin (default)
+1.79.0-x86_64-apple-darwin
+
+installed targets for active toolchain
+--------------------------------------
+
+wasm32-unknown-unknown
+x86_64-apple-darwin
+
+active toolchain
+----------------
+
+1.79.0-x86_64-apple-darwin (overridden by '/src/app/rust-toolchain.toml')
+rustc 1.79.0 (129f3b996 2024-06-10)
+
+`
+
+const currentShow = `Default host: aarch64-apple-darwin
+rustup home:  /Users/dev/.rustup
+
+installed toolchains
+--------------------
+stable-aarch64-apple-darwin (default)
+1.79.0-aarch64-apple-darwin (active)
+
+active toolchain
+----------------
+name: 1.79.0-aarch64-apple-darwin
+active because: overridden by '/src/app/rust-toolchain.toml'
+installed targets:
+  aarch64-apple-darwin
+  wasm32-unknown-unknown
+`
+
+func (s *rustupSuite) TestParseLegacyShow() {
+	show := parseShow(legacyShow)
+
+	s.Require().Equal(&Show{
+		Host:       "x86_64-apple-darwin",
+		Toolchains: []string{"stable-x86_64-apple-darwin", "1.79.0-x86_64-apple-darwin"},
+		Default:    "stable-x86_64-apple-darwin",
+		Active:     "1.79.0-x86_64-apple-darwin",
+		Targets:    []string{"wasm32-unknown-unknown", "x86_64-apple-darwin"},
+	}, show)
+	s.Require().True(show.HasToolchain("1.79.0"))
+	s.Require().False(show.HasToolchain("nightly"))
+}
+
+func (s *rustupSuite) TestParseCurrentShow() {
+	show := parseShow(currentShow)
+
+	s.Require().Equal(&Show{
+		Host:       "aarch64-apple-darwin",
+		Toolchains: []string{"stable-aarch64-apple-darwin", "1.79.0-aarch64-apple-darwin"},
+		Default:    "stable-aarch64-apple-darwin",
+		Active:     "1.79.0-aarch64-apple-darwin",
+		Targets:    []string{"aarch64-apple-darwin", "wasm32-unknown-unknown"},
+	}, show)
+}
+
+func (s *rustupSuite) TestParseShowWithoutToolchains() {
+	show := parseShow("Default host: x86_64-unknown-linux-gnu\nrustup home:  /home/dev/.rustup\n\nno active toolchain\n")
+
+	s.Require().Equal("x86_64-unknown-linux-gnu", show.Host)
+	s.Require().Empty(show.Toolchains)
+	s.Require().Empty(show.Default)
+}
+
+func (s *rustupSuite) TestParseToolchainFile() {
+	content := `[toolchain]
+channel = "1.79.0" # pinned
+profile = "minimal"
+components = [
+  "rustfmt",
+  "clippy",
+]
+targets = ["wasm32-unknown-unknown"]
+`
+
+	s.Require().Equal(&Toolchain{
+		Channel:    "1.79.0",
+		Profile:    "minimal",
+		Components: []string{"rustfmt", "clippy"},
+		Targets:    []string{"wasm32-unknown-unknown"},
+	}, ParseToolchainFile(content))
+}
+
+func (s *rustupSuite) TestParseLegacyToolchainFile() {
+	s.Require().Equal(&Toolchain{
+		Channel:    "nightly-2024-05-01",
+		Components: []string{},
+		Targets:    []string{},
+	}, ParseToolchainFile("nightly-2024-05-01\n"))
+}
+
+func (s *rustupSuite) TestInstallToolchain() {
+	cmd := fakecmdexec.NewNoOpCommand()
+	c := newClientWithComponents(fakecmdexec.NewCmdGenerator(cmd))
+
+	err := c.InstallToolchain(&Toolchain{
+		Channel:    "1.79.0",
+		Profile:    "minimal",
+		Components: []string{"rustfmt", "clippy"},
+		Targets:    []string{"wasm32-unknown-unknown"},
+	})
+
+	s.Require().NoError(err)
+	s.Require().Equal("rustup", cmd.Cmd())
+	s.Require().Equal([]string{
+		"toolchain", "install", "1.79.0",
+		"--profile", "minimal",
+		"--component", "rustfmt",
+		"--component", "clippy",
+		"--target", "wasm32-unknown-unknown",
+	}, cmd.Args())
+}
+
+func (s *rustupSuite) TestInstalledComponents() {
+	cmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: "cargo-x86_64-apple-darwin\nclippy-x86_64-apple-darwin\nrust-src\n",
+	})
+	c := newClientWithComponents(fakecmdexec.NewCmdGenerator(cmd))
+
+	components, err := c.InstalledComponents("1.79.0")
+
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"cargo-x86_64-apple-darwin", "clippy-x86_64-apple-darwin", "rust-src"}, components)
+	s.Require().Equal([]string{"component", "list", "--installed", "--toolchain", "1.79.0"}, cmd.Args())
+}
+
+func (s *rustupSuite) TestAddTargets() {
+	cmd := fakecmdexec.NewNoOpCommand()
+	c := newClientWithComponents(fakecmdexec.NewCmdGenerator(cmd))
+
+	s.Require().NoError(c.AddTargets("1.79.0", []string{"wasm32-unknown-unknown"}))
+	s.Require().Equal([]string{"target", "add", "--toolchain", "1.79.0", "wasm32-unknown-unknown"}, cmd.Args())
+}
+
+func TestRustupSuite(t *testing.T) {
+	suite.Run(t, new(rustupSuite))
+}
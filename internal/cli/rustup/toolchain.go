@@ -0,0 +1,103 @@
+package rustup
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	keyValueRe = regexp.MustCompile(`^([\w-]+)\s*=\s*(.*)$`)
+	quotedRe   = regexp.MustCompile(`"([^"]*)"|'([^']*)'`)
+)
+
+// Toolchain is the toolchain a project requires, as declared in
+// rust-toolchain.toml.
+type Toolchain struct {
+	Channel    string
+	Profile    string
+	Components []string
+	Targets    []string
+}
+
+// ParseToolchainFile reads a rust-toolchain.toml file or a legacy
+// rust-toolchain file, which only holds the channel name.
+func ParseToolchainFile(content string) *Toolchain {
+	toolchain := &Toolchain{
+		Components: []string{},
+		Targets:    []string{},
+	}
+
+	if !strings.Contains(content, "[toolchain]") {
+		for _, line := range strings.Split(content, "\n") {
+			if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+				toolchain.Channel = line
+				break
+			}
+		}
+
+		return toolchain
+	}
+
+	inToolchain := false
+	key, value := "", ""
+
+	for _, line := range strings.Split(content, "\n") {
+		line, _, _ = strings.Cut(line, "#")
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		// arrays may span several lines
+		if key != "" {
+			value += " " + line
+			if strings.Contains(line, "]") {
+				toolchain.set(key, value)
+				key = ""
+			}
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			inToolchain = line == "[toolchain]"
+			continue
+		}
+
+		matches := keyValueRe.FindStringSubmatch(line)
+		if !inToolchain || matches == nil {
+			continue
+		}
+
+		key, value = matches[1], matches[2]
+		if strings.HasPrefix(value, "[") && !strings.Contains(value, "]") {
+			continue
+		}
+
+		toolchain.set(key, value)
+		key = ""
+	}
+
+	return toolchain
+}
+
+func (t *Toolchain) set(key, value string) {
+	values := []string{}
+	for _, matches := range quotedRe.FindAllStringSubmatch(value, -1) {
+		values = append(values, matches[1]+matches[2])
+	}
+
+	if len(values) == 0 {
+		return
+	}
+
+	switch key {
+	case "channel":
+		t.Channel = values[0]
+	case "profile":
+		t.Profile = values[0]
+	case "components":
+		t.Components = values
+	case "targets":
+		t.Targets = values
+	}
+}
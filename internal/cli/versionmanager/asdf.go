@@ -0,0 +1,112 @@
+package versionmanager
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
+	"github.com/renegumroad/gum-cli/internal/cli/homebrew"
+	"github.com/renegumroad/gum-cli/internal/log"
+)
+
+// asdfPlugins maps tools to the asdf plugin managing them when the names
+// differ.
+var asdfPlugins = map[string]string{
+	Node: "nodejs",
+}
+
+type asdfClient struct {
+	cmdGen cmdexec.CmdGenerator
+}
+
+func newAsdfClient(gen cmdexec.CmdGenerator) *asdfClient {
+	return &asdfClient{
+		cmdGen: gen,
+	}
+}
+
+func (c *asdfClient) Name() string {
+	return Asdf
+}
+
+func (c *asdfClient) Package() homebrew.Package {
+	return homebrew.Package{Name: "asdf"}
+}
+
+// Supports returns true for any tool, since asdf relies on a plugin per tool
+// that is added on demand.
+func (c *asdfClient) Supports(_ string) bool {
+	return true
+}
+
+func (c *asdfClient) InstalledVersions(tool string) ([]string, error) {
+	cmd := c.cmdGen("asdf", "list", asdfPlugin(tool))
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Errorf("Failed to list %s versions: %s %s", tool, err, cmd.Stderr())
+	}
+
+	versions := []string{}
+	for _, line := range strings.Split(cmd.Stdout(), "\n") {
+		// The current version is flagged with a leading "*"
+		version := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*"))
+		if version != "" && !strings.HasPrefix(version, "No versions") {
+			versions = append(versions, version)
+		}
+	}
+
+	return versions, nil
+}
+
+func (c *asdfClient) IsInstalled(tool, version string) bool {
+	versions, err := c.InstalledVersions(tool)
+	if err != nil {
+		log.Debugf("%s", err)
+		return false
+	}
+
+	return containsVersion(versions, version)
+}
+
+func (c *asdfClient) Install(tool, version string) error {
+	if err := c.ensurePlugin(tool); err != nil {
+		return err
+	}
+
+	log.Infof("Installing %s %s with asdf", tool, version)
+
+	cmd := c.cmdGen("asdf", "install", asdfPlugin(tool), version)
+	if err := cmd.Run(); err != nil {
+		return errors.Errorf("Failed %s %s installation: %s %s", tool, version, err, cmd.Stderr())
+	}
+
+	return nil
+}
+
+func (c *asdfClient) ensurePlugin(tool string) error {
+	plugin := asdfPlugin(tool)
+
+	cmd := c.cmdGen("asdf", "plugin", "list")
+	if err := cmd.Run(); err == nil {
+		if slices.Contains(strings.Fields(cmd.Stdout()), plugin) {
+			return nil
+		}
+	}
+
+	log.Infof("Adding asdf plugin %s", plugin)
+
+	cmd = c.cmdGen("asdf", "plugin", "add", plugin)
+	if err := cmd.Run(); err != nil {
+		return errors.Errorf("Failed to add asdf plugin %s: %s %s", plugin, err, cmd.Stderr())
+	}
+
+	return nil
+}
+
+func asdfPlugin(tool string) string {
+	if plugin, found := asdfPlugins[tool]; found {
+		return plugin
+	}
+
+	return tool
+}
@@ -0,0 +1,75 @@
+package versionmanager
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
+	"github.com/renegumroad/gum-cli/internal/cli/homebrew"
+	"github.com/renegumroad/gum-cli/internal/log"
+)
+
+type miseClient struct {
+	cmdGen cmdexec.CmdGenerator
+}
+
+func newMiseClient(gen cmdexec.CmdGenerator) *miseClient {
+	return &miseClient{
+		cmdGen: gen,
+	}
+}
+
+func (c *miseClient) Name() string {
+	return Mise
+}
+
+func (c *miseClient) Package() homebrew.Package {
+	return homebrew.Package{Name: "mise"}
+}
+
+func (c *miseClient) Supports(_ string) bool {
+	return true
+}
+
+func (c *miseClient) InstalledVersions(tool string) ([]string, error) {
+	cmd := c.cmdGen("mise", "ls", "--installed", "--json", tool)
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Errorf("Failed to list %s versions: %s %s", tool, err, cmd.Stderr())
+	}
+
+	entries := []struct {
+		Version string `json:"version"`
+	}{}
+	if err := json.Unmarshal([]byte(cmd.Stdout()), &entries); err != nil {
+		return nil, errors.Errorf("Unable to parse mise output: %s", err)
+	}
+
+	versions := []string{}
+	for _, entry := range entries {
+		versions = append(versions, entry.Version)
+	}
+
+	return versions, nil
+}
+
+func (c *miseClient) IsInstalled(tool, version string) bool {
+	versions, err := c.InstalledVersions(tool)
+	if err != nil {
+		log.Debugf("%s", err)
+		return false
+	}
+
+	return containsVersion(versions, version)
+}
+
+func (c *miseClient) Install(tool, version string) error {
+	log.Infof("Installing %s %s with mise", tool, version)
+
+	cmd := c.cmdGen("mise", "install", fmt.Sprintf("%s@%s", tool, version))
+	if err := cmd.Run(); err != nil {
+		return errors.Errorf("Failed %s %s installation: %s %s", tool, version, err, cmd.Stderr())
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package versionmanager
+
+import (
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/homebrew"
+	"github.com/renegumroad/gum-cli/internal/cli/rbenv"
+)
+
+// rbenvClient adapts rbenv, which only manages ruby.
+type rbenvClient struct {
+	rbenv rbenv.Client
+}
+
+func newRbenvClient(rb rbenv.Client) *rbenvClient {
+	return &rbenvClient{
+		rbenv: rb,
+	}
+}
+
+func (c *rbenvClient) Name() string {
+	return Rbenv
+}
+
+func (c *rbenvClient) Package() homebrew.Package {
+	return homebrew.Package{Name: "rbenv"}
+}
+
+func (c *rbenvClient) Supports(tool string) bool {
+	return tool == Ruby
+}
+
+func (c *rbenvClient) InstalledVersions(tool string) ([]string, error) {
+	if !c.Supports(tool) {
+		return nil, errors.Errorf("%s does not support %s", c.Name(), tool)
+	}
+
+	return c.rbenv.InstalledVersions()
+}
+
+func (c *rbenvClient) IsInstalled(tool, version string) bool {
+	versions, err := c.InstalledVersions(tool)
+	if err != nil {
+		return false
+	}
+
+	return containsVersion(versions, version)
+}
+
+func (c *rbenvClient) Install(tool, version string) error {
+	if !c.Supports(tool) {
+		return errors.Errorf("%s does not support %s", c.Name(), tool)
+	}
+
+	return c.rbenv.InstallVersion(version)
+}
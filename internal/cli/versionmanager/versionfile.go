@@ -0,0 +1,198 @@
+package versionmanager
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+)
+
+var (
+	Ruby   = "ruby"
+	Node   = "node"
+	Python = "python"
+
+	// toolFiles are the single-tool version files, checked before the
+	// multi-tool .tool-versions and mise.toml files.
+	toolFiles = map[string][]string{
+		Ruby:   {".ruby-version"},
+		Node:   {".node-version", ".nvmrc"},
+		Python: {".python-version"},
+	}
+
+	// toolAliases are the other names a tool is known by in the multi-tool
+	// files, e.g. the asdf plugin for node is called nodejs.
+	toolAliases = map[string][]string{
+		Node: {"nodejs"},
+	}
+
+	toolVersionsFile = ".tool-versions"
+	miseFiles        = []string{"mise.toml", ".mise.toml"}
+	packageJSONFile  = "package.json"
+
+	miseToolRe    = regexp.MustCompile(`^\s*"?([\w@/:.-]+)"?\s*=\s*(.+?)\s*$`)
+	quotedRe      = regexp.MustCompile(`"([^"]*)"|'([^']*)'`)
+	engineRe      = regexp.MustCompile(`^v?(\d+(?:\.\d+){0,2})(?:\.x)*$`)
+	prefixedVerRe = regexp.MustCompile(`^v\d`)
+)
+
+// Version is a required tool version along with the file that declared it.
+type Version struct {
+	Tool    string
+	Version string
+	File    string
+}
+
+// RequiredVersion looks for the version of tool declared in dir. It returns
+// nil when no file declares one.
+func RequiredVersion(fs filesystem.Client, dir, tool string) (*Version, error) {
+	type source struct {
+		file  string
+		parse func(tool, content string) string
+	}
+
+	sources := []source{}
+	for _, name := range toolFiles[tool] {
+		sources = append(sources, source{name, parseToolFile})
+	}
+
+	sources = append(sources, source{toolVersionsFile, parseToolVersions})
+	for _, name := range miseFiles {
+		sources = append(sources, source{name, parseMiseToml})
+	}
+
+	if tool == Node {
+		sources = append(sources, source{packageJSONFile, parseEngines})
+	}
+
+	for _, src := range sources {
+		path := filepath.Join(dir, src.file)
+		if !fs.Exists(path) {
+			continue
+		}
+
+		content, err := fs.ReadString(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if version := src.parse(tool, content); version != "" {
+			return &Version{Tool: tool, Version: version, File: src.file}, nil
+		}
+	}
+
+	log.Debugf("No %s version declared in %s", tool, dir)
+
+	return nil, nil
+}
+
+func toolNames(tool string) []string {
+	return append([]string{tool}, toolAliases[tool]...)
+}
+
+func parseToolFile(tool, content string) string {
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		// rbenv and others accept an optional "<tool>-" prefix and nvm
+		// an optional "v" one
+		line = strings.TrimPrefix(line, tool+"-")
+		if prefixedVerRe.MatchString(line) {
+			line = line[1:]
+		}
+
+		return line
+	}
+
+	return ""
+}
+
+// parseToolVersions reads the asdf format: one "<tool> <version>..." per
+// line, where the first version is the preferred one.
+func parseToolVersions(tool, content string) string {
+	for _, line := range strings.Split(content, "\n") {
+		line, _, _ = strings.Cut(line, "#")
+		fields := strings.Fields(line)
+
+		if len(fields) >= 2 && slices.Contains(toolNames(tool), fields[0]) {
+			return fields[1]
+		}
+	}
+
+	return ""
+}
+
+// parseMiseToml reads the [tools] table of a mise config. Values can be a
+// string, an array of strings or an inline table with a version key.
+func parseMiseToml(tool, content string) string {
+	inTools := false
+
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			inTools = line == "[tools]"
+			continue
+		}
+
+		if !inTools {
+			continue
+		}
+
+		matches := miseToolRe.FindStringSubmatch(line)
+		if matches == nil || !slices.Contains(toolNames(tool), matches[1]) {
+			continue
+		}
+
+		value := matches[2]
+		if strings.HasPrefix(value, "{") {
+			_, value, _ = strings.Cut(value, "version")
+		}
+
+		quoted := quotedRe.FindStringSubmatch(value)
+		if quoted == nil {
+			return ""
+		}
+
+		return quoted[1] + quoted[2]
+	}
+
+	return ""
+}
+
+// parseEngines reads the engines field of a package.json. Only exact
+// versions and bare major or minor versions such as "20" or "20.x" are
+// used, since version managers can't install a range.
+func parseEngines(tool, content string) string {
+	pkg := struct {
+		Engines map[string]string `json:"engines"`
+	}{}
+
+	if err := json.Unmarshal([]byte(content), &pkg); err != nil {
+		log.Debugf("Unable to parse %s: %s", packageJSONFile, err)
+		return ""
+	}
+
+	engine := strings.TrimSpace(pkg.Engines[tool])
+	if engine == "" {
+		return ""
+	}
+
+	matches := engineRe.FindStringSubmatch(engine)
+	if matches == nil {
+		log.Debugf("Ignoring %s engine range %q from %s", tool, engine, packageJSONFile)
+		return ""
+	}
+
+	return matches[1]
+}
@@ -0,0 +1,91 @@
+package versionmanager
+
+import (
+	"os/exec"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
+	"github.com/renegumroad/gum-cli/internal/cli/homebrew"
+	"github.com/renegumroad/gum-cli/internal/cli/rbenv"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/userconfig"
+)
+
+var (
+	Rbenv = "rbenv"
+	Asdf  = "asdf"
+	Mise  = "mise"
+
+	// detectionOrder lists the managers checked when the user config doesn't
+	// name one. rbenv comes last since it only manages ruby.
+	detectionOrder = []string{Mise, Asdf, Rbenv}
+)
+
+// Client installs language runtimes through a version manager.
+type Client interface {
+	Name() string
+	// Package is the brew package providing the manager.
+	Package() homebrew.Package
+	Supports(tool string) bool
+	InstalledVersions(tool string) ([]string, error)
+	IsInstalled(tool, version string) bool
+	Install(tool, version string) error
+}
+
+// New returns the version manager named in the user config or, when there is
+// none, the first one found in the PATH. rbenv is used as a last resort.
+func New() (Client, error) {
+	config, err := userconfig.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := resolve(config.VersionManager, exec.LookPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return newClient(name, cmdexec.NewCommandGenerator()), nil
+}
+
+func resolve(configured string, lookPath func(file string) (string, error)) (string, error) {
+	if configured != "" {
+		if !slices.Contains(detectionOrder, configured) {
+			return "", errors.Errorf("Unsupported version manager %s. Supported: %s", configured, detectionOrder)
+		}
+
+		log.Debugf("Using version manager %s from user config", configured)
+		return configured, nil
+	}
+
+	for _, name := range detectionOrder {
+		if _, err := lookPath(name); err == nil {
+			log.Debugf("Detected version manager %s", name)
+			return name, nil
+		}
+	}
+
+	log.Debugf("No version manager detected, defaulting to %s", Rbenv)
+	return Rbenv, nil
+}
+
+func newClient(name string, gen cmdexec.CmdGenerator) Client {
+	switch name {
+	case Asdf:
+		return newAsdfClient(gen)
+	case Mise:
+		return newMiseClient(gen)
+	default:
+		return newRbenvClient(rbenv.New())
+	}
+}
+
+// containsVersion reports whether version is installed. A partial version
+// such as "20" or "20.1" is satisfied by any installed release of that line.
+func containsVersion(versions []string, version string) bool {
+	return slices.ContainsFunc(versions, func(installed string) bool {
+		return installed == version || strings.HasPrefix(installed, version+".")
+	})
+}
@@ -0,0 +1,245 @@
+package versionmanager
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec/fakecmdexec"
+	"github.com/renegumroad/gum-cli/internal/cli/rbenv/mockrbenv"
+	"github.com/renegumroad/gum-cli/internal/filesystem/mockfilesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type versionManagerSuite struct {
+	suite.Suite
+	mockFs *mockfilesystem.MockClient
+}
+
+func (s *versionManagerSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *versionManagerSuite) SetupTest() {
+	s.mockFs = mockfilesystem.NewMockClient(s.T())
+}
+
+func lookPathFor(available ...string) func(string) (string, error) {
+	return func(file string) (string, error) {
+		for _, name := range available {
+			if name == file {
+				return "/usr/local/bin/" + file, nil
+			}
+		}
+		return "", errors.New("executable file not found in $PATH")
+	}
+}
+
+func (s *versionManagerSuite) TestResolveFromConfig() {
+	name, err := resolve("asdf", lookPathFor("mise"))
+
+	s.Require().NoError(err)
+	s.Require().Equal(Asdf, name)
+}
+
+func (s *versionManagerSuite) TestResolveUnsupportedConfig() {
+	_, err := resolve("nvm", lookPathFor())
+
+	s.Require().ErrorContains(err, "Unsupported version manager nvm")
+}
+
+func (s *versionManagerSuite) TestResolveDetectsInstalled() {
+	name, err := resolve("", lookPathFor("rbenv", "asdf"))
+
+	s.Require().NoError(err)
+	s.Require().Equal(Asdf, name)
+}
+
+func (s *versionManagerSuite) TestResolveDefaultsToRbenv() {
+	name, err := resolve("", lookPathFor())
+
+	s.Require().NoError(err)
+	s.Require().Equal(Rbenv, name)
+}
+
+func (s *versionManagerSuite) TestRequiredVersionFromRubyVersion() {
+	s.mockFs.EXPECT().Exists("/app/.ruby-version").Return(true)
+	s.mockFs.EXPECT().ReadString("/app/.ruby-version").Return("ruby-3.3.4\n", nil)
+
+	version, err := RequiredVersion(s.mockFs, "/app", Ruby)
+
+	s.Require().NoError(err)
+	s.Require().Equal(&Version{Tool: Ruby, Version: "3.3.4", File: ".ruby-version"}, version)
+}
+
+func (s *versionManagerSuite) TestRequiredVersionFromToolVersions() {
+	s.mockFs.EXPECT().Exists("/app/.ruby-version").Return(false)
+	s.mockFs.EXPECT().Exists("/app/.tool-versions").Return(true)
+	s.mockFs.EXPECT().ReadString("/app/.tool-versions").Return("# runtimes\nnodejs 20.15.0\nruby 3.3.4 3.2.2\n", nil)
+
+	version, err := RequiredVersion(s.mockFs, "/app", Ruby)
+
+	s.Require().NoError(err)
+	s.Require().Equal(&Version{Tool: Ruby, Version: "3.3.4", File: ".tool-versions"}, version)
+}
+
+func (s *versionManagerSuite) TestRequiredVersionFromMiseToml() {
+	s.mockFs.EXPECT().Exists("/app/.ruby-version").Return(false)
+	s.mockFs.EXPECT().Exists("/app/.tool-versions").Return(false)
+	s.mockFs.EXPECT().Exists("/app/mise.toml").Return(true)
+	s.mockFs.EXPECT().ReadString("/app/mise.toml").Return("[env]\nruby = \"ignored\"\n\n[tools]\nnode = \"20\"\nruby = \"3.3.4\" # pinned\n", nil)
+
+	version, err := RequiredVersion(s.mockFs, "/app", Ruby)
+
+	s.Require().NoError(err)
+	s.Require().Equal(&Version{Tool: Ruby, Version: "3.3.4", File: "mise.toml"}, version)
+}
+
+func (s *versionManagerSuite) TestRequiredVersionNotDeclared() {
+	s.mockFs.EXPECT().Exists("/app/.ruby-version").Return(false)
+	s.mockFs.EXPECT().Exists("/app/.tool-versions").Return(false)
+	s.mockFs.EXPECT().Exists("/app/mise.toml").Return(false)
+	s.mockFs.EXPECT().Exists("/app/.mise.toml").Return(false)
+
+	version, err := RequiredVersion(s.mockFs, "/app", Ruby)
+
+	s.Require().NoError(err)
+	s.Require().Nil(version)
+}
+
+func (s *versionManagerSuite) TestRequiredNodeVersionFromNvmrc() {
+	s.mockFs.EXPECT().Exists("/app/.node-version").Return(false)
+	s.mockFs.EXPECT().Exists("/app/.nvmrc").Return(true)
+	s.mockFs.EXPECT().ReadString("/app/.nvmrc").Return("v20.15.0\n", nil)
+
+	version, err := RequiredVersion(s.mockFs, "/app", Node)
+
+	s.Require().NoError(err)
+	s.Require().Equal(&Version{Tool: Node, Version: "20.15.0", File: ".nvmrc"}, version)
+}
+
+func (s *versionManagerSuite) TestRequiredNodeVersionFromToolVersionsAlias() {
+	s.mockFs.EXPECT().Exists("/app/.node-version").Return(false)
+	s.mockFs.EXPECT().Exists("/app/.nvmrc").Return(false)
+	s.mockFs.EXPECT().Exists("/app/.tool-versions").Return(true)
+	s.mockFs.EXPECT().ReadString("/app/.tool-versions").Return("nodejs 20.15.0\nruby 3.3.4\n", nil)
+
+	version, err := RequiredVersion(s.mockFs, "/app", Node)
+
+	s.Require().NoError(err)
+	s.Require().Equal(&Version{Tool: Node, Version: "20.15.0", File: ".tool-versions"}, version)
+}
+
+func (s *versionManagerSuite) TestRequiredNodeVersionFromEngines() {
+	s.mockFs.EXPECT().Exists("/app/.node-version").Return(false)
+	s.mockFs.EXPECT().Exists("/app/.nvmrc").Return(false)
+	s.mockFs.EXPECT().Exists("/app/.tool-versions").Return(false)
+	s.mockFs.EXPECT().Exists("/app/mise.toml").Return(false)
+	s.mockFs.EXPECT().Exists("/app/.mise.toml").Return(false)
+	s.mockFs.EXPECT().Exists("/app/package.json").Return(true)
+	s.mockFs.EXPECT().ReadString("/app/package.json").Return(`{"name": "web", "engines": {"node": "20.x"}}`, nil)
+
+	version, err := RequiredVersion(s.mockFs, "/app", Node)
+
+	s.Require().NoError(err)
+	s.Require().Equal(&Version{Tool: Node, Version: "20", File: "package.json"}, version)
+}
+
+func (s *versionManagerSuite) TestParseEngines() {
+	s.Require().Equal("20.15.0", parseEngines(Node, `{"engines": {"node": "v20.15.0"}}`))
+	s.Require().Equal("20.1", parseEngines(Node, `{"engines": {"node": "20.1.x"}}`))
+	s.Require().Equal("", parseEngines(Node, `{"engines": {"node": ">=18 <21"}}`))
+	s.Require().Equal("", parseEngines(Node, `{"name": "web"}`))
+}
+
+func (s *versionManagerSuite) TestContainsVersion() {
+	s.Require().True(containsVersion([]string{"18.20.1", "20.15.0"}, "20"))
+	s.Require().True(containsVersion([]string{"20.15.0"}, "20.15.0"))
+	s.Require().False(containsVersion([]string{"200.1.0"}, "20"))
+}
+
+func (s *versionManagerSuite) TestParseMiseToml() {
+	s.Require().Equal("3.3.4", parseMiseToml("ruby", "[tools]\nruby = [\"3.3.4\", \"3.2\"]\n"))
+	s.Require().Equal("3.3.4", parseMiseToml("ruby", "[tools]\nruby = { version = '3.3.4', virtualenv = '.venv' }\n"))
+	s.Require().Equal("", parseMiseToml("ruby", "[tools]\nnode = \"20\"\n"))
+}
+
+func (s *versionManagerSuite) TestAsdfInstalledVersions() {
+	listCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: "  3.2.2\n *3.3.4\n",
+	})
+	c := newAsdfClient(fakecmdexec.NewCmdGenerator(listCmd))
+
+	s.Require().True(c.IsInstalled(Ruby, "3.3.4"))
+	s.Require().Equal("asdf", listCmd.Cmd())
+	s.Require().Equal([]string{"list", "ruby"}, listCmd.Args())
+}
+
+func (s *versionManagerSuite) TestAsdfInstallAddsMissingPlugin() {
+	pluginListCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: "nodejs\n",
+	})
+	pluginAddCmd := fakecmdexec.NewNoOpCommand()
+	installCmd := fakecmdexec.NewNoOpCommand()
+	c := newAsdfClient(fakecmdexec.NewCmdGenerator(pluginListCmd, pluginAddCmd, installCmd))
+
+	err := c.Install(Ruby, "3.3.4")
+
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"plugin", "add", "ruby"}, pluginAddCmd.Args())
+	s.Require().Equal([]string{"install", "ruby", "3.3.4"}, installCmd.Args())
+}
+
+func (s *versionManagerSuite) TestAsdfInstallWithExistingPlugin() {
+	pluginListCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: "nodejs\nruby\n",
+	})
+	installCmd := fakecmdexec.NewNoOpCommand()
+	c := newAsdfClient(fakecmdexec.NewCmdGenerator(pluginListCmd, installCmd))
+
+	s.Require().NoError(c.Install(Ruby, "3.3.4"))
+	s.Require().Equal([]string{"install", "ruby", "3.3.4"}, installCmd.Args())
+}
+
+func (s *versionManagerSuite) TestAsdfUsesNodejsPlugin() {
+	pluginListCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: "nodejs\n",
+	})
+	installCmd := fakecmdexec.NewNoOpCommand()
+	c := newAsdfClient(fakecmdexec.NewCmdGenerator(pluginListCmd, installCmd))
+
+	s.Require().NoError(c.Install(Node, "20.15.0"))
+	s.Require().Equal([]string{"install", "nodejs", "20.15.0"}, installCmd.Args())
+}
+
+func (s *versionManagerSuite) TestMise() {
+	listCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: `[{"version":"3.2.2","install_path":"/home/dev/.local/share/mise/installs/ruby/3.2.2","installed":true,"active":false}]`,
+	})
+	installCmd := fakecmdexec.NewNoOpCommand()
+	c := newMiseClient(fakecmdexec.NewCmdGenerator(listCmd, installCmd))
+
+	s.Require().False(c.IsInstalled(Ruby, "3.3.4"))
+	s.Require().Equal([]string{"ls", "--installed", "--json", "ruby"}, listCmd.Args())
+
+	s.Require().NoError(c.Install(Ruby, "3.3.4"))
+	s.Require().Equal("mise", installCmd.Cmd())
+	s.Require().Equal([]string{"install", "ruby@3.3.4"}, installCmd.Args())
+}
+
+func (s *versionManagerSuite) TestRbenvOnlySupportsRuby() {
+	mockRbenv := mockrbenv.NewMockClient(s.T())
+	mockRbenv.EXPECT().InstalledVersions().Return([]string{"3.3.4"}, nil)
+	mockRbenv.EXPECT().InstallVersion("3.3.5").Return(nil)
+	c := newRbenvClient(mockRbenv)
+
+	s.Require().True(c.IsInstalled(Ruby, "3.3.4"))
+	s.Require().NoError(c.Install(Ruby, "3.3.5"))
+	s.Require().False(c.Supports("node"))
+	s.Require().Error(c.Install("node", "20"))
+}
+
+func TestVersionManagerSuite(t *testing.T) {
+	suite.Run(t, new(versionManagerSuite))
+}
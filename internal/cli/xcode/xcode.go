@@ -1,17 +1,40 @@
 package xcode
 
 import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
 	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
 	"github.com/renegumroad/gum-cli/internal/log"
 )
 
+const (
+	pollInterval     = 5 * time.Second
+	progressInterval = time.Minute
+	cltPackage       = "com.apple.pkg.CLTools_Executables"
+)
+
+// Info describes the active developer directory. FullXcode is set when it
+// belongs to Xcode.app rather than to the Command Line Tools, in which case
+// Version is the Xcode version.
+type Info struct {
+	Path      string
+	FullXcode bool
+	Version   string
+}
+
 type Client interface {
 	IsInstalled() bool
-	EnsureInstalled() error
+	EnsureInstalled(timeout time.Duration) error
+	Info() (*Info, error)
+	LicenseAccepted() bool
 }
 
 type client struct {
-	cmdGen cmdexec.CmdGenerator
+	cmdGen   cmdexec.CmdGenerator
+	interval time.Duration
 }
 
 func New() Client {
@@ -22,9 +45,10 @@ func New() Client {
 
 func newClientWithComponents(
 	gen cmdexec.CmdGenerator,
-) Client {
+) *client {
 	return &client{
-		cmdGen: gen,
+		cmdGen:   gen,
+		interval: pollInterval,
 	}
 }
 
@@ -36,7 +60,10 @@ func (c *client) IsInstalled() bool {
 	return err == nil
 }
 
-func (c *client) EnsureInstalled() error {
+// EnsureInstalled starts the installation of the Command Line Tools and waits
+// until it completes. xcode-select --install only opens the installer dialog
+// and returns right away, so the tools are polled until timeout.
+func (c *client) EnsureInstalled(timeout time.Duration) error {
 	if c.IsInstalled() {
 		log.Debugln("xcode is already installed")
 		return nil
@@ -44,6 +71,127 @@ func (c *client) EnsureInstalled() error {
 
 	log.Debugln("Installing xcode")
 	cmd := c.cmdGen("xcode-select", "--install")
+	if err := cmd.Run(); err != nil {
+		return errors.Errorf("Failed xcode-select --install: %s %s", err, cmd.Stderr())
+	}
+
+	log.Infof("Follow the installer dialog to install the Command Line Tools. Waiting up to %s for it to finish", timeout)
+
+	start := time.Now()
+	lastProgress := start
+	for {
+		time.Sleep(c.interval)
+
+		if c.IsInstalled() {
+			log.Infof("Command Line Tools are installed")
+			return nil
+		}
+
+		if time.Since(start) >= timeout {
+			return errors.Errorf("Command Line Tools were not installed after %s. Finish the installation and run gum again", timeout)
+		}
+
+		if time.Since(lastProgress) >= progressInterval {
+			lastProgress = time.Now()
+			log.Infof("Still waiting for the Command Line Tools installation (%s elapsed)", time.Since(start).Round(time.Second))
+		}
+	}
+}
+
+// Info reads the active developer directory and its version, from
+// xcodebuild for Xcode.app and from the package receipt for the Command Line
+// Tools.
+func (c *client) Info() (*Info, error) {
+	path, err := c.run("xcode-select", "-p")
+	if err != nil {
+		return nil, err
+	}
+
+	info := &Info{
+		Path:      strings.TrimSpace(path),
+		FullXcode: strings.Contains(path, ".app/"),
+	}
+
+	if info.FullXcode {
+		out, err := c.run("xcodebuild", "-version")
+		if err != nil {
+			return nil, err
+		}
+		info.Version = parseField(out, "Xcode ")
+	} else {
+		out, err := c.run("pkgutil", "--pkg-info="+cltPackage)
+		if err != nil {
+			return nil, err
+		}
+		info.Version = parseField(out, "version: ")
+	}
+
+	if info.Version == "" {
+		return nil, errors.Errorf("Unable to find the version of %s", info.Path)
+	}
+
+	return info, nil
+}
+
+// LicenseAccepted reports whether the Xcode license was accepted. Only
+// Xcode.app requires it, so it is always true for the Command Line Tools.
+func (c *client) LicenseAccepted() bool {
+	cmd := c.cmdGen("xcodebuild", "-license", "check")
+	if err := cmd.Run(); err != nil {
+		log.Debugf("Xcode license check failed: %s %s", err, cmd.Stderr())
+		return false
+	}
+
+	return true
+}
+
+func (c *client) run(name string, args ...string) (string, error) {
+	cmd := c.cmdGen(name, args...)
+	if err := cmd.Run(); err != nil {
+		return "", errors.Errorf("Failed %s %s: %s %s", name, strings.Join(args, " "), err, cmd.Stderr())
+	}
+
+	return cmd.Stdout(), nil
+}
+
+func parseField(out, prefix string) string {
+	for _, line := range strings.Split(out, "\n") {
+		if value, found := strings.CutPrefix(strings.TrimSpace(line), prefix); found {
+			return strings.TrimSpace(value)
+		}
+	}
+
+	return ""
+}
+
+// CompareVersions compares dotted numeric versions, returning -1, 0 or 1.
+// Missing components count as 0, so 15 equals 15.0.0.
+func CompareVersions(a, b string) int {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		aNum, bNum := versionPart(aParts, i), versionPart(bParts, i)
+		if aNum < bNum {
+			return -1
+		}
+		if aNum > bNum {
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func versionPart(parts []string, i int) int {
+	if i >= len(parts) {
+		return 0
+	}
+
+	num, err := strconv.Atoi(parts[i])
+	if err != nil {
+		return 0
+	}
 
-	return cmd.Run()
+	return num
 }
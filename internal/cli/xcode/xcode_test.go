@@ -2,6 +2,7 @@ package xcode
 
 import (
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/renegumroad/gum-cli/internal/cli/cmdexec/fakecmdexec"
@@ -47,16 +48,38 @@ func (s *xcodeSuite) TestEnsureInstalledAlreadyInstalled() {
 		Err: errors.Errorf("xcode-select command not found"),
 	})
 	xcodeInstallCmd := fakecmdexec.NewNoOpCommand()
+	xcodePendingCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Err: errors.Errorf("xcode-select: error: unable to get active developer directory"),
+	})
+	xcodeReadyCmd := fakecmdexec.NewNoOpCommand()
 
-	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(xcodeCheckCmd, xcodeInstallCmd))
+	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(xcodeCheckCmd, xcodeInstallCmd, xcodePendingCmd, xcodeReadyCmd))
+	client.interval = time.Millisecond
 
-	err := client.EnsureInstalled()
+	err := client.EnsureInstalled(time.Minute)
 
 	s.Require().NoError(err)
 	s.Require().Equal("xcode-select", xcodeCheckCmd.Cmd())
 	s.Require().Equal([]string{"-p"}, xcodeCheckCmd.Args())
 	s.Require().Equal("xcode-select", xcodeInstallCmd.Cmd())
 	s.Require().Equal([]string{"--install"}, xcodeInstallCmd.Args())
+	s.Require().Equal([]string{"-p"}, xcodePendingCmd.Args())
+	s.Require().Equal([]string{"-p"}, xcodeReadyCmd.Args())
+}
+
+func (s *xcodeSuite) TestEnsureInstalledTimeout() {
+	notInstalled := func() fakecmdexec.SettableCommand {
+		return fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+			Err: errors.Errorf("xcode-select: error: unable to get active developer directory"),
+		})
+	}
+
+	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(notInstalled(), fakecmdexec.NewNoOpCommand(), notInstalled()))
+	client.interval = 10 * time.Millisecond
+
+	err := client.EnsureInstalled(time.Millisecond)
+
+	s.Require().ErrorContains(err, "Command Line Tools were not installed after 1ms")
 }
 
 func (s *xcodeSuite) TestEnsureInstalledNotInstalled() {
@@ -65,7 +88,7 @@ func (s *xcodeSuite) TestEnsureInstalledNotInstalled() {
 
 	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(xcodeCheckCmd, xcodeInstallCmd))
 
-	err := client.EnsureInstalled()
+	err := client.EnsureInstalled(time.Minute)
 
 	s.Require().NoError(err)
 	s.Require().Equal("xcode-select", xcodeCheckCmd.Cmd())
@@ -74,6 +97,52 @@ func (s *xcodeSuite) TestEnsureInstalledNotInstalled() {
 	s.Require().Equal([]string{}, xcodeInstallCmd.Args())
 }
 
+func (s *xcodeSuite) TestInfoCommandLineTools() {
+	pathCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{Stdout: "/Library/Developer/CommandLineTools\n"})
+	pkgCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Stdout: "package-id: com.apple.pkg.CLTools_Executables\nversion: 15.3.0.0.1.1708646388\nvolume: /\n",
+	})
+	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(pathCmd, pkgCmd))
+
+	info, err := client.Info()
+
+	s.Require().NoError(err)
+	s.Require().Equal(&Info{Path: "/Library/Developer/CommandLineTools", Version: "15.3.0.0.1.1708646388"}, info)
+	s.Require().Equal("pkgutil", pkgCmd.Cmd())
+	s.Require().Equal([]string{"--pkg-info=com.apple.pkg.CLTools_Executables"}, pkgCmd.Args())
+}
+
+func (s *xcodeSuite) TestInfoXcode() {
+	pathCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{Stdout: "/Applications/Xcode.app/Contents/Developer\n"})
+	versionCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{Stdout: "Xcode 15.4\nBuild version 15F31d\n"})
+	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(pathCmd, versionCmd))
+
+	info, err := client.Info()
+
+	s.Require().NoError(err)
+	s.Require().Equal(&Info{Path: "/Applications/Xcode.app/Contents/Developer", FullXcode: true, Version: "15.4"}, info)
+	s.Require().Equal("xcodebuild", versionCmd.Cmd())
+	s.Require().Equal([]string{"-version"}, versionCmd.Args())
+}
+
+func (s *xcodeSuite) TestLicenseAccepted() {
+	checkCmd := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{
+		Err:    errors.Errorf("exit status 69"),
+		Stderr: "You have not agreed to the Xcode license agreements.",
+	})
+	client := newClientWithComponents(fakecmdexec.NewCmdGenerator(checkCmd))
+
+	s.Require().False(client.LicenseAccepted())
+	s.Require().Equal("xcodebuild", checkCmd.Cmd())
+	s.Require().Equal([]string{"-license", "check"}, checkCmd.Args())
+}
+
+func (s *xcodeSuite) TestCompareVersions() {
+	s.Require().Equal(0, CompareVersions("15", "15.0.0"))
+	s.Require().Equal(-1, CompareVersions("14.3.1", "15.0"))
+	s.Require().Equal(1, CompareVersions("15.3.0.0.1.1708646388", "15.3"))
+}
+
 func TestXcodeSuite(t *testing.T) {
 	suite.Run(t, new(xcodeSuite))
 }
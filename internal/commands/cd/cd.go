@@ -0,0 +1,63 @@
+package cd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/repos"
+)
+
+type CdImpl struct {
+	out      io.Writer
+	name     string
+	newRepos func() (repos.Client, error)
+	repos    repos.Client
+}
+
+// New prints the path of the cloned repository matching name, for the gum
+// shell function to cd into it.
+func New(out io.Writer, name string) *CdImpl {
+	return newWithComponents(out, name, repos.New)
+}
+
+func newWithComponents(out io.Writer, name string, newRepos func() (repos.Client, error)) *CdImpl {
+	return &CdImpl{
+		out:      out,
+		name:     name,
+		newRepos: newRepos,
+	}
+}
+
+func (impl *CdImpl) Validate() error {
+	log.Debugf("Validating cd command")
+
+	client, err := impl.newRepos()
+	if err != nil {
+		return err
+	}
+	impl.repos = client
+
+	return nil
+}
+
+func (impl *CdImpl) Run() error {
+	log.Debugf("Running cd command")
+
+	paths, err := impl.repos.Find(impl.name)
+	if err != nil {
+		return err
+	}
+
+	switch len(paths) {
+	case 0:
+		return errors.Errorf("No repository %s in %s. Clone it with gum clone <org>/%s", impl.name, impl.repos.Root(), impl.name)
+	case 1:
+		fmt.Fprintln(impl.out, paths[0])
+		return nil
+	default:
+		return errors.Errorf("Several repositories match %s, use org/repo or host/org/repo:\n%s", impl.name, strings.Join(paths, "\n"))
+	}
+}
@@ -0,0 +1,101 @@
+package cd
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/renegumroad/gum-cli/assets"
+
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/repos"
+	"github.com/renegumroad/gum-cli/internal/repos/mockrepos"
+	"github.com/stretchr/testify/suite"
+)
+
+type cdSuite struct {
+	suite.Suite
+	mockRepos *mockrepos.MockClient
+	out       *bytes.Buffer
+}
+
+func (s *cdSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *cdSuite) SetupTest() {
+	s.mockRepos = mockrepos.NewMockClient(s.T())
+	s.out = &bytes.Buffer{}
+}
+
+func (s *cdSuite) newImpl(name string) *CdImpl {
+	impl := newWithComponents(s.out, name, func() (repos.Client, error) {
+		return s.mockRepos, nil
+	})
+	s.Require().NoError(impl.Validate())
+
+	return impl
+}
+
+func (s *cdSuite) TestRunPrintsPath() {
+	s.mockRepos.EXPECT().Find("web").Return([]string{"/src/github.com/gumroad/web"}, nil)
+
+	s.Require().NoError(s.newImpl("web").Run())
+	s.Require().Equal("/src/github.com/gumroad/web\n", s.out.String())
+}
+
+func (s *cdSuite) TestRunErrors() {
+	s.mockRepos.EXPECT().Find("web").Return([]string{}, nil).Once()
+	s.mockRepos.EXPECT().Root().Return("/src")
+	s.Require().EqualError(s.newImpl("web").Run(), "No repository web in /src. Clone it with gum clone <org>/web")
+
+	s.mockRepos.EXPECT().Find("web").Return([]string{"/src/github.com/a/web", "/src/github.com/b/web"}, nil).Once()
+	s.Require().EqualError(s.newImpl("web").Run(), "Several repositories match web, use org/repo or host/org/repo:\n/src/github.com/a/web\n/src/github.com/b/web")
+	s.Require().Empty(s.out.String())
+}
+
+// TestShellFunction runs the gum shell function of the shell config with a
+// fake gum, which logs its errors to stderr like the real one.
+func (s *cdSuite) TestShellFunction() {
+	dir := s.T().TempDir()
+	shellConfig, err := assets.GetAsset("shell_config.tmpl")
+	s.Require().NoError(err)
+	s.Require().NoError(os.WriteFile(filepath.Join(dir, "shell_config"), shellConfig, 0644))
+	s.Require().NoError(os.WriteFile(filepath.Join(dir, "gum"), []byte(`#!/bin/sh
+if [ "$2" = "web" ]; then
+  echo "$GUM_TEST_REPO"
+else
+  echo "fatal: No repository $2" >&2
+  exit 1
+fi
+`), 0755))
+
+	run := func(repo string) (string, string, error) {
+		script := `. "$1/shell_config"; gum cd "$2"; status=$?; echo "$PWD"; exit $status`
+		cmd := exec.Command("bash", "-c", script, "bash", dir, repo)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "PATH="+dir+":"+os.Getenv("PATH"), "GUM_TEST_REPO="+s.T().TempDir())
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+		cmd.Stdout, cmd.Stderr = stdout, stderr
+
+		err := cmd.Run()
+		return stdout.String(), stderr.String(), err
+	}
+
+	stdout, stderr, err := run("api")
+	s.Require().Error(err)
+	s.Require().Equal(dir+"\n", stdout)
+	s.Require().Equal("fatal: No repository api\n", stderr)
+
+	stdout, stderr, err = run("web")
+	s.Require().NoError(err)
+	s.Require().NotEqual(dir+"\n", stdout)
+	s.Require().Empty(stderr)
+}
+
+func TestCdSuite(t *testing.T) {
+	suite.Run(t, new(cdSuite))
+}
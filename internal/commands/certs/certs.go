@@ -0,0 +1,111 @@
+package certs
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/renegumroad/gum-cli/internal/actions"
+	"github.com/renegumroad/gum-cli/internal/certs"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/gumconfig"
+	"github.com/renegumroad/gum-cli/internal/log"
+)
+
+type CertsImpl struct {
+	out     io.Writer
+	trust   bool
+	fs      filesystem.Client
+	certs   certs.Client
+	entries []*actions.CertsArgs
+	dir     string
+}
+
+// New sets up the local CA and the certificates of the gum.yml of the current
+// directory. With trust, the CA is also added to the system trust store.
+func New(out io.Writer, trust bool) *CertsImpl {
+	return newWithComponents(out, trust, filesystem.New(), certs.New())
+}
+
+func newWithComponents(out io.Writer, trust bool, fs filesystem.Client, certsClient certs.Client) *CertsImpl {
+	return &CertsImpl{
+		out:   out,
+		trust: trust,
+		fs:    fs,
+		certs: certsClient,
+	}
+}
+
+func (impl *CertsImpl) Validate() error {
+	log.Debugf("Validating certs command")
+
+	dir, err := impl.fs.CurrentDir()
+	if err != nil {
+		return err
+	}
+	impl.dir = dir
+
+	impl.entries = []*actions.CertsArgs{}
+	if !gumconfig.Exists(dir) {
+		log.Debugf("No gum config in %s, only the CA is set up", dir)
+		return nil
+	}
+
+	config, err := gumconfig.New(dir)
+	if err != nil {
+		return err
+	}
+
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	for _, up := range config.Up {
+		if up.Certs != nil {
+			impl.entries = append(impl.entries, up.Certs)
+		}
+	}
+
+	return nil
+}
+
+func (impl *CertsImpl) Run() error {
+	log.Debugf("Running certs command")
+
+	commands, err := impl.ensureCertificates()
+	if err != nil {
+		return err
+	}
+
+	if len(commands) == 0 {
+		log.Infof("The local CA is trusted by the system")
+		return nil
+	}
+
+	if impl.trust {
+		return impl.certs.Trust()
+	}
+
+	_, err = fmt.Fprintf(impl.out, "Trust the local CA with gum certs --trust, or by running:\n%s\n", strings.Join(commands, "\n"))
+	return err
+}
+
+// ensureCertificates sets up the CA and the certificates of gum.yml, and
+// returns the commands trusting the CA.
+func (impl *CertsImpl) ensureCertificates() ([]string, error) {
+	if len(impl.entries) == 0 {
+		return impl.certs.EnsureCertificate(nil, "", "")
+	}
+
+	commands := []string{}
+	for _, entry := range impl.entries {
+		cert, key := entry.Paths(impl.dir)
+
+		var err error
+		if commands, err = impl.certs.EnsureCertificate(entry.Hosts, cert, key); err != nil {
+			return nil, err
+		}
+	}
+
+	return commands, nil
+}
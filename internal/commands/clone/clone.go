@@ -0,0 +1,61 @@
+package clone
+
+import (
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/repos"
+)
+
+type CloneImpl struct {
+	spec     string
+	newRepos func() (repos.Client, error)
+	repos    repos.Client
+	repo     *repos.Repo
+}
+
+// New clones the repository of spec, such as org/repo, in the source root.
+func New(spec string) *CloneImpl {
+	return newWithComponents(spec, repos.New)
+}
+
+func newWithComponents(spec string, newRepos func() (repos.Client, error)) *CloneImpl {
+	return &CloneImpl{
+		spec:     spec,
+		newRepos: newRepos,
+	}
+}
+
+func (impl *CloneImpl) Validate() error {
+	log.Debugf("Validating clone command")
+
+	repo, err := repos.Parse(impl.spec)
+	if err != nil {
+		return err
+	}
+	impl.repo = repo
+
+	client, err := impl.newRepos()
+	if err != nil {
+		return err
+	}
+	impl.repos = client
+
+	return nil
+}
+
+func (impl *CloneImpl) Run() error {
+	log.Debugf("Running clone command")
+
+	if impl.repos.IsCloned(impl.repo) {
+		log.Infof("%s is already cloned in %s", impl.repo, impl.repos.Path(impl.repo))
+		return nil
+	}
+
+	path, err := impl.repos.Clone(impl.repo, "")
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Cloned %s. Run gum cd %s to go there", impl.repo, impl.repo.Name)
+	log.Debugf("Cloned in %s", path)
+	return nil
+}
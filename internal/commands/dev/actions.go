@@ -0,0 +1,41 @@
+package dev
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/renegumroad/gum-cli/internal/actions"
+	"github.com/renegumroad/gum-cli/internal/log"
+)
+
+type ActionsImpl struct {
+	out io.Writer
+}
+
+func NewActions(out io.Writer) *ActionsImpl {
+	return &ActionsImpl{
+		out: out,
+	}
+}
+
+func (impl *ActionsImpl) Validate() error {
+	return nil
+}
+
+// Run lists the named actions and the with: parameters they accept.
+func (impl *ActionsImpl) Run() error {
+	log.Debugf("Running actions command")
+
+	for _, name := range actions.PublicNames() {
+		description, err := actions.Describe(name)
+		if err != nil {
+			return err
+		}
+
+		if _, err := fmt.Fprintf(impl.out, "%s\n%s\n", name, description); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
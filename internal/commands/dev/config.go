@@ -0,0 +1,32 @@
+package dev
+
+import (
+	"fmt"
+
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/gumconfig"
+)
+
+// loadConfig reads and validates the gum config of the current directory.
+func loadConfig(fs filesystem.Client) (*gumconfig.GumConfig, error) {
+	currentDir, err := fs.CurrentDir()
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := gumconfig.New(currentDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// configSource describes where an up entry was declared, for error messages.
+func configSource(index int) string {
+	return fmt.Sprintf("gum.yml up[%d]", index)
+}
@@ -0,0 +1,85 @@
+package dev
+
+import (
+	"slices"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/actions"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+)
+
+type DbResetImpl struct {
+	fs        filesystem.Client
+	names     []string
+	databases []*actions.DatabaseAction
+}
+
+// NewDbReset resets the databases of gum.yml with the given names, or all of
+// them when names is empty.
+func NewDbReset(names []string) *DbResetImpl {
+	return newDbResetWithComponents(names, filesystem.New())
+}
+
+func newDbResetWithComponents(names []string, fs filesystem.Client) *DbResetImpl {
+	return &DbResetImpl{
+		fs:    fs,
+		names: names,
+	}
+}
+
+func (impl *DbResetImpl) Validate() error {
+	log.Debugf("Validating db reset command")
+
+	config, err := loadConfig(impl.fs)
+	if err != nil {
+		return err
+	}
+
+	impl.databases = []*actions.DatabaseAction{}
+	found := []string{}
+	for i, up := range config.Up {
+		if up.Database == nil {
+			continue
+		}
+
+		found = append(found, up.Database.Name)
+		if len(impl.names) > 0 && !slices.Contains(impl.names, up.Database.Name) {
+			continue
+		}
+
+		database := actions.NewDatabaseAction(configSource(i), up.Database)
+		if err := database.Validate(); err != nil {
+			return err
+		}
+
+		impl.databases = append(impl.databases, database)
+	}
+
+	for _, name := range impl.names {
+		if !slices.Contains(found, name) {
+			return errors.Errorf("Database %s is not declared in gum.yml", name)
+		}
+	}
+
+	return nil
+}
+
+func (impl *DbResetImpl) Run() error {
+	log.Debugf("Running db reset command")
+
+	if len(impl.databases) == 0 {
+		log.Infoln("No databases declared in gum.yml")
+		return nil
+	}
+
+	for _, database := range impl.databases {
+		if err := database.Reset(); err != nil {
+			return err
+		}
+	}
+
+	log.Infoln("Databases reset successfully")
+
+	return nil
+}
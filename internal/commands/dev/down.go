@@ -0,0 +1,66 @@
+package dev
+
+import (
+	"github.com/renegumroad/gum-cli/internal/actions"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+)
+
+type DownImpl struct {
+	fs       filesystem.Client
+	services []*actions.ServiceAction
+}
+
+func NewDown() *DownImpl {
+	return newDownWithComponents(filesystem.New())
+}
+
+func newDownWithComponents(fs filesystem.Client) *DownImpl {
+	return &DownImpl{
+		fs: fs,
+	}
+}
+
+func (impl *DownImpl) Validate() error {
+	log.Debugf("Validating down command")
+
+	config, err := loadConfig(impl.fs)
+	if err != nil {
+		return err
+	}
+
+	impl.services = []*actions.ServiceAction{}
+	for i, up := range config.Up {
+		if len(up.Services) == 0 {
+			continue
+		}
+
+		service := actions.NewServiceAction(configSource(i), up.Services)
+		if err := service.Validate(); err != nil {
+			return err
+		}
+
+		impl.services = append(impl.services, service)
+	}
+
+	return nil
+}
+
+func (impl *DownImpl) Run() error {
+	log.Debugf("Running down command")
+
+	if len(impl.services) == 0 {
+		log.Infoln("No services declared in gum.yml")
+		return nil
+	}
+
+	for _, service := range impl.services {
+		if err := service.Stop(); err != nil {
+			return err
+		}
+	}
+
+	log.Infoln("Services stopped successfully")
+
+	return nil
+}
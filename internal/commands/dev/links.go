@@ -0,0 +1,57 @@
+package dev
+
+import (
+	"github.com/renegumroad/gum-cli/internal/actions"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+)
+
+type LinksImpl struct {
+	fs      filesystem.Client
+	adopt   bool
+	handler *actions.ActionHandler
+}
+
+// NewLinks creates the links entries of gum.yml. With adopt, the files
+// already in place are moved into the project or the dotfiles repository.
+func NewLinks(adopt bool) *LinksImpl {
+	return newLinksWithComponents(adopt, filesystem.New())
+}
+
+func newLinksWithComponents(adopt bool, fs filesystem.Client) *LinksImpl {
+	return &LinksImpl{
+		fs:    fs,
+		adopt: adopt,
+	}
+}
+
+func (impl *LinksImpl) Validate() error {
+	log.Debugf("Validating links command")
+
+	config, err := loadConfig(impl.fs)
+	if err != nil {
+		return err
+	}
+
+	links := []actions.Action{}
+	for i, up := range config.Up {
+		if up.Links != nil {
+			links = append(links, actions.NewLinksAction(configSource(i), up.Links, impl.adopt))
+		}
+	}
+
+	impl.handler = actions.NewActionHandler(links)
+
+	return impl.handler.Validate()
+}
+
+func (impl *LinksImpl) Run() error {
+	log.Debugf("Running links command")
+
+	if len(impl.handler.Actions) == 0 {
+		log.Infoln("No links declared in gum.yml")
+		return nil
+	}
+
+	return impl.handler.Run()
+}
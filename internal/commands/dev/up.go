@@ -1,6 +1,8 @@
 package dev
 
 import (
+	"slices"
+
 	"github.com/renegumroad/gum-cli/internal/actions"
 	"github.com/renegumroad/gum-cli/internal/filesystem"
 	"github.com/renegumroad/gum-cli/internal/gumconfig"
@@ -25,34 +27,28 @@ func newUpWithComponents(fs filesystem.Client) *UpImpl {
 
 func (impl *UpImpl) Validate() error {
 	log.Debugf("Validating up command")
-	currentDir, err := impl.fs.CurrentDir()
+	config, err := loadConfig(impl.fs)
 	if err != nil {
 		return err
 	}
-
-	impl.config, err = gumconfig.New(currentDir)
-	if err != nil {
-		return err
-	}
-
-	if err := impl.config.Validate(); err != nil {
-		return err
-	}
+	impl.config = config
 
 	parsedActions := []actions.Action{}
 
-	for _, up := range impl.config.Up {
-		var action actions.Action
-
-		if up.Action != "" {
-			action = actions.Get(string(up.Action))
-		} else {
-			action = actions.NewBrewAction(up.Brew)
+	for i, up := range impl.config.Up {
+		action, err := buildAction(i, up, config.Secrets)
+		if err != nil {
+			return err
 		}
 
 		parsedActions = append(parsedActions, action)
 	}
 
+	// removes the hosts block of the project once gum.yml has no hosts entries
+	if !slices.ContainsFunc(impl.config.Up, func(up gumconfig.UpAction) bool { return len(up.Hosts) > 0 }) {
+		parsedActions = append(parsedActions, actions.NewHostsAction("gum.yml", nil))
+	}
+
 	impl.handler = actions.NewActionHandler(parsedActions)
 
 	if err := impl.handler.Validate(); err != nil {
@@ -73,3 +69,38 @@ func (impl *UpImpl) Run() error {
 
 	return nil
 }
+
+func buildAction(index int, up gumconfig.UpAction, secrets map[string]string) (actions.Action, error) {
+	source := configSource(index)
+
+	switch {
+	case up.Action != "":
+		return actions.NewNamedAction(string(up.Action), up.With)
+	case len(up.Services) > 0:
+		return actions.NewServiceAction(source, up.Services), nil
+	case up.GitHubRelease != nil:
+		return actions.NewGitHubReleaseAction(source, up.GitHubRelease), nil
+	case up.Download != nil:
+		return actions.NewDownloadAction(source, up.Download), nil
+	case up.Template != nil:
+		return actions.NewTemplateAction(source, up.Template), nil
+	case up.Links != nil:
+		return actions.NewLinksAction(source, up.Links, false), nil
+	case len(up.Repos) > 0:
+		return actions.NewReposAction(source, up.Repos), nil
+	case up.GitHooks != nil:
+		return actions.NewGitHooksAction(source, up.GitHooks), nil
+	case up.Dotenv != nil:
+		return actions.NewDotenvAction(source, up.Dotenv, secrets), nil
+	case len(up.Hosts) > 0:
+		return actions.NewHostsAction(source, up.Hosts), nil
+	case up.Certs != nil:
+		return actions.NewCertsAction(source, up.Certs), nil
+	case up.Database != nil:
+		return actions.NewDatabaseAction(source, up.Database), nil
+	case up.SystemPackages != nil:
+		return actions.NewSystemPackagesAction(up.SystemPackages), nil
+	default:
+		return actions.NewBrewAction(source, up.Brew), nil
+	}
+}
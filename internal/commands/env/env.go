@@ -0,0 +1,80 @@
+package env
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/gumconfig"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/secrets"
+)
+
+type EnvImpl struct {
+	out        io.Writer
+	fs         filesystem.Client
+	secrets    secrets.Client
+	references map[string]string
+}
+
+// New prints export statements for the secrets of the gum.yml of the current
+// directory.
+func New(out io.Writer) *EnvImpl {
+	return newWithComponents(out, filesystem.New(), secrets.New())
+}
+
+func newWithComponents(out io.Writer, fs filesystem.Client, secretsClient secrets.Client) *EnvImpl {
+	return &EnvImpl{
+		out:     out,
+		fs:      fs,
+		secrets: secretsClient,
+	}
+}
+
+func (impl *EnvImpl) Validate() error {
+	log.Debugf("Validating env command")
+
+	dir, err := impl.fs.CurrentDir()
+	if err != nil {
+		return err
+	}
+
+	config, err := gumconfig.New(dir)
+	if err != nil {
+		return err
+	}
+
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	impl.references = config.Secrets
+	return nil
+}
+
+func (impl *EnvImpl) Run() error {
+	log.Debugf("Running env command")
+
+	values, err := impl.secrets.Resolve(impl.references)
+	if err != nil {
+		return err
+	}
+
+	names := []string{}
+	for name := range values {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		fmt.Fprintf(impl.out, "export %s=%s\n", name, shellQuote(values[name]))
+	}
+
+	return nil
+}
+
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
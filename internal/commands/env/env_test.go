@@ -0,0 +1,49 @@
+package env
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/filesystem/mockfilesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/secrets/mocksecrets"
+	"github.com/stretchr/testify/suite"
+)
+
+type envSuite struct {
+	suite.Suite
+	mockFs      *mockfilesystem.MockClient
+	mockSecrets *mocksecrets.MockClient
+}
+
+func (s *envSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *envSuite) SetupTest() {
+	s.mockFs = mockfilesystem.NewMockClient(s.T())
+	s.mockSecrets = mocksecrets.NewMockClient(s.T())
+}
+
+func (s *envSuite) TestRunPrintsExports() {
+	references := map[string]string{
+		"STRIPE_KEY":   "op://dev/stripe/key",
+		"GITHUB_TOKEN": "env://GITHUB_TOKEN",
+	}
+	s.mockSecrets.EXPECT().Resolve(references).Return(map[string]string{
+		"STRIPE_KEY":   "sk_'test",
+		"GITHUB_TOKEN": "ghp_token",
+	}, nil)
+
+	out := &bytes.Buffer{}
+	impl := newWithComponents(out, s.mockFs, s.mockSecrets)
+	impl.references = references
+
+	s.Require().NoError(impl.Run())
+	s.Require().Equal("export GITHUB_TOKEN='ghp_token'\nexport STRIPE_KEY='sk_'\\''test'\n", out.String())
+}
+
+func TestEnvSuite(t *testing.T) {
+	suite.Run(t, new(envSuite))
+}
@@ -0,0 +1,70 @@
+package install
+
+import (
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/releases"
+)
+
+type InstallImpl struct {
+	args        string
+	name        string
+	asset       string
+	path        string
+	newReleases func() (releases.Client, error)
+	releases    releases.Client
+	spec        *releases.Spec
+}
+
+// New installs the binary of the GitHub release of args, owner/repo with an
+// optional @version, in /opt/gumroad/bin. name, asset and path override the
+// binary name, the asset pattern and the binary inside the archive.
+func New(args, name, asset, path string) *InstallImpl {
+	return newWithComponents(args, name, asset, path, releases.New)
+}
+
+func newWithComponents(args, name, asset, path string, newReleases func() (releases.Client, error)) *InstallImpl {
+	return &InstallImpl{
+		args:        args,
+		name:        name,
+		asset:       asset,
+		path:        path,
+		newReleases: newReleases,
+	}
+}
+
+func (impl *InstallImpl) Validate() error {
+	log.Debugf("Validating install command")
+
+	spec, err := releases.ParseSpec(impl.args)
+	if err != nil {
+		return err
+	}
+	spec.Name = impl.name
+	spec.Asset = impl.asset
+	spec.Path = impl.path
+
+	if err := spec.Validate(); err != nil {
+		return err
+	}
+	impl.spec = spec
+
+	client, err := impl.newReleases()
+	if err != nil {
+		return err
+	}
+	impl.releases = client
+
+	return nil
+}
+
+func (impl *InstallImpl) Run() error {
+	log.Debugf("Running install command")
+
+	entry, err := impl.releases.Install(impl.spec)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("%s %s from %s is installed", impl.spec.BinaryName(), entry.Version, entry.Repo)
+	return nil
+}
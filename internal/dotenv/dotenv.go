@@ -0,0 +1,154 @@
+package dotenv
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	assignmentRe = regexp.MustCompile(`^\s*(?:export\s+)?([A-Za-z_][A-Za-z0-9_]*)\s*=\s*(.*)$`)
+	plainValueRe = regexp.MustCompile(`^[A-Za-z0-9_./:@+,=-]*$`)
+)
+
+// Line is a line of a dotenv file. Key is empty for comments and blank
+// lines, which only have Raw.
+type Line struct {
+	Key   string
+	Value string
+	Raw   string
+}
+
+// File is a parsed dotenv file, keeping its comments and ordering.
+type File struct {
+	Lines []Line
+}
+
+// Parse reads KEY=value lines, optionally prefixed by export, with single,
+// double or no quotes.
+func Parse(content string) *File {
+	file := &File{Lines: []Line{}}
+
+	content = strings.TrimSuffix(content, "\n")
+	if content == "" {
+		return file
+	}
+
+	for _, raw := range strings.Split(content, "\n") {
+		matches := assignmentRe.FindStringSubmatch(raw)
+		if matches == nil || strings.HasPrefix(strings.TrimSpace(raw), "#") {
+			file.Lines = append(file.Lines, Line{Raw: raw})
+			continue
+		}
+
+		file.Lines = append(file.Lines, Line{Key: matches[1], Value: parseValue(matches[2]), Raw: raw})
+	}
+
+	return file
+}
+
+func parseValue(value string) string {
+	value = strings.TrimSpace(value)
+
+	if len(value) >= 2 && value[0] == '"' {
+		if end := strings.LastIndex(value, `"`); end > 0 {
+			unquoted := value[1:end]
+			unquoted = strings.ReplaceAll(unquoted, `\n`, "\n")
+			unquoted = strings.ReplaceAll(unquoted, `\"`, `"`)
+			return strings.ReplaceAll(unquoted, `\\`, `\`)
+		}
+	}
+
+	if len(value) >= 2 && value[0] == '\'' {
+		if end := strings.LastIndex(value, "'"); end > 0 {
+			return value[1:end]
+		}
+	}
+
+	// unquoted values end at an inline comment
+	if idx := strings.Index(value, " #"); idx >= 0 {
+		value = value[:idx]
+	}
+
+	return strings.TrimSpace(value)
+}
+
+// Keys returns the keys of the file in order.
+func (f *File) Keys() []string {
+	keys := []string{}
+	for _, line := range f.Lines {
+		if line.Key != "" {
+			keys = append(keys, line.Key)
+		}
+	}
+
+	return keys
+}
+
+// Value returns the value of the last assignment of key.
+func (f *File) Value(key string) (string, bool) {
+	line, found := f.line(key)
+	return line.Value, found
+}
+
+// line returns the last assignment of key.
+func (f *File) line(key string) (Line, bool) {
+	last, found := Line{}, false
+	for _, line := range f.Lines {
+		if line.Key == key {
+			last, found = line, true
+		}
+	}
+
+	return last, found
+}
+
+// Render writes the template, keeping its comments. Keys of values are
+// written with their value; the others keep their line of current as is,
+// since quoting them again could change how dotenv loaders read them. Keys of
+// current that are not in the template are appended, so that values added by
+// hand are not lost.
+func Render(template, current *File, values map[string]string) string {
+	var b strings.Builder
+
+	for _, line := range template.Lines {
+		if line.Key == "" {
+			b.WriteString(line.Raw + "\n")
+			continue
+		}
+
+		b.WriteString(renderLine(line.Key, current, values) + "\n")
+	}
+
+	written := map[string]bool{}
+	for _, key := range current.Keys() {
+		if _, found := template.line(key); found || written[key] {
+			continue
+		}
+
+		b.WriteString(renderLine(key, current, values) + "\n")
+		written[key] = true
+	}
+
+	return b.String()
+}
+
+func renderLine(key string, current *File, values map[string]string) string {
+	if value, found := values[key]; found {
+		return key + "=" + quote(value)
+	}
+
+	line, _ := current.line(key)
+	return line.Raw
+}
+
+func quote(value string) string {
+	if plainValueRe.MatchString(value) {
+		return value
+	}
+
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	value = strings.ReplaceAll(value, "\n", `\n`)
+
+	return `"` + value + `"`
+}
@@ -0,0 +1,76 @@
+package dotenv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const dotenvTestTemplate = `# Rails
+RAILS_ENV=development
+export PORT=3000 # web server
+
+# Stripe
+STRIPE_KEY=
+GREETING="hello \"dev\""
+PATTERN='a#b'
+`
+
+type dotenvSuite struct {
+	suite.Suite
+}
+
+func (s *dotenvSuite) TestParse() {
+	file := Parse(dotenvTestTemplate)
+
+	s.Require().Equal([]string{"RAILS_ENV", "PORT", "STRIPE_KEY", "GREETING", "PATTERN"}, file.Keys())
+
+	for key, expected := range map[string]string{
+		"RAILS_ENV":  "development",
+		"PORT":       "3000",
+		"STRIPE_KEY": "",
+		"GREETING":   `hello "dev"`,
+		"PATTERN":    "a#b",
+	} {
+		value, found := file.Value(key)
+		s.Require().True(found, key)
+		s.Require().Equal(expected, value, key)
+	}
+
+	_, found := file.Value("MISSING")
+	s.Require().False(found)
+}
+
+func (s *dotenvSuite) TestRender() {
+	template := Parse(dotenvTestTemplate)
+	current := Parse("RAILS_ENV=development\nexport PORT=4000\nPASSWORD='pa$$word'\nLOCAL_ONLY=\"two words\"\n")
+	values := map[string]string{
+		"STRIPE_KEY": "sk_test_123",
+		"GREETING":   `hello "dev"`,
+		"PATTERN":    "a#b",
+	}
+
+	rendered := Render(template, current, values)
+
+	s.Require().Equal(`# Rails
+RAILS_ENV=development
+export PORT=4000
+
+# Stripe
+STRIPE_KEY=sk_test_123
+GREETING="hello \"dev\""
+PATTERN="a#b"
+PASSWORD='pa$$word'
+LOCAL_ONLY="two words"
+`, rendered)
+
+	// the rendered file reads back the same values
+	for key, expected := range map[string]string{"PORT": "4000", "PASSWORD": "pa$$word", "LOCAL_ONLY": "two words", "GREETING": `hello "dev"`, "PATTERN": "a#b"} {
+		value, _ := Parse(rendered).Value(key)
+		s.Require().Equal(expected, value, key)
+	}
+}
+
+func TestDotenvSuite(t *testing.T) {
+	suite.Run(t, new(dotenvSuite))
+}
@@ -0,0 +1,276 @@
+package download
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+)
+
+const (
+	FormatTarGz = "tar.gz"
+	FormatZip   = "zip"
+)
+
+// Formats are the supported archive formats. An empty format is a file
+// installed as is.
+var Formats = []string{FormatTarGz, FormatZip}
+
+// Client downloads files into a cache keyed by their checksum, and installs
+// binaries out of them.
+type Client interface {
+	// Fetch returns the path of the file of url in the cache, named after its
+	// sha256, downloading it when it is not there. The file must match the
+	// checksum. An empty checksum skips the verification, and the cache.
+	Fetch(url, checksum string) (string, error)
+	// FetchWith is Fetch for a file read with open, such as an asset of a
+	// private GitHub repository. name identifies the file in logs and errors.
+	FetchWith(name, checksum string, open func() (io.ReadCloser, error)) (string, error)
+	// Install writes the file, or its member when format is an archive
+	// format, to dest as an executable. dest is replaced atomically. A member
+	// without a directory matches a file of that name anywhere in the archive.
+	Install(file, format, member, dest string) error
+}
+
+type client struct {
+	cacheDir string
+	http     *http.Client
+	fs       filesystem.Client
+}
+
+func New() (Client, error) {
+	fs := filesystem.New()
+
+	homeDir, err := fs.HomeDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return newClientWithComponents(filepath.Join(homeDir, ".gum", "cache", "downloads"), http.DefaultClient, fs), nil
+}
+
+func newClientWithComponents(cacheDir string, httpClient *http.Client, fs filesystem.Client) *client {
+	return &client{
+		cacheDir: cacheDir,
+		http:     httpClient,
+		fs:       fs,
+	}
+}
+
+func (c *client) Fetch(url, checksum string) (string, error) {
+	return c.FetchWith(url, checksum, func() (io.ReadCloser, error) {
+		resp, err := c.http.Get(url)
+		if err != nil {
+			return nil, errors.Errorf("Failed to download %s: %s", url, err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, errors.Errorf("Failed to download %s: %s", url, resp.Status)
+		}
+
+		return resp.Body, nil
+	})
+}
+
+func (c *client) FetchWith(name, checksum string, open func() (io.ReadCloser, error)) (string, error) {
+	checksum = strings.ToLower(checksum)
+	cached := filepath.Join(c.cacheDir, checksum)
+
+	if checksum != "" && c.fs.IsFile(cached) {
+		if sum, err := fileSha256(cached); err == nil && sum == checksum {
+			log.Debugf("Using cached download of %s", name)
+			return cached, nil
+		}
+
+		log.Debugf("Cached download of %s is corrupted, downloading it again", name)
+	}
+
+	if err := c.fs.MkdirAll(c.cacheDir); err != nil {
+		return "", err
+	}
+
+	log.Infof("Downloading %s", name)
+	body, err := open()
+	if err != nil {
+		return "", err
+	}
+	defer body.Close()
+
+	f, err := os.CreateTemp(c.cacheDir, ".download.*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(f, hash), body); err != nil {
+		return "", errors.Errorf("Failed to download %s: %s", name, err)
+	}
+
+	sum := hex.EncodeToString(hash.Sum(nil))
+	if checksum == "" {
+		cached = filepath.Join(c.cacheDir, sum)
+	} else if sum != checksum {
+		return "", errors.Errorf("Checksum mismatch for %s: expected sha256 %s, got %s", name, checksum, sum)
+	}
+
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+
+	if err := c.fs.Rename(f.Name(), cached); err != nil {
+		return "", err
+	}
+
+	return cached, nil
+}
+
+func (c *client) Install(file, format, member, dest string) error {
+	src, closeSrc, err := open(file, format, member)
+	if err != nil {
+		return err
+	}
+	defer closeSrc()
+
+	tmp, err := os.CreateTemp(filepath.Dir(dest), "."+filepath.Base(dest)+".*")
+	if err != nil {
+		return errors.Errorf("Failed to create a temporary file for %s: %s", dest, err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	if _, err := io.Copy(tmp, src); err != nil {
+		return errors.Errorf("Failed to write %s: %s", dest, err)
+	}
+
+	if err := tmp.Chmod(0755); err != nil {
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return c.fs.Rename(tmp.Name(), dest)
+}
+
+// open returns a reader of the file, or of its member for archives.
+func open(file, format, member string) (io.Reader, func(), error) {
+	switch format {
+	case "":
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return f, func() { f.Close() }, nil
+	case FormatTarGz:
+		return openTarGz(file, member)
+	case FormatZip:
+		return openZip(file, member)
+	default:
+		return nil, nil, errors.Errorf("Unsupported archive format %s. Supported: %s", format, strings.Join(Formats, ", "))
+	}
+}
+
+func openTarGz(file, member string) (io.Reader, func(), error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, nil, errors.Errorf("Failed to read %s: %s", file, err)
+	}
+
+	closeAll := func() {
+		gz.Close()
+		f.Close()
+	}
+
+	archive := tar.NewReader(gz)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			closeAll()
+			return nil, nil, errors.Errorf("Failed to read %s: %s", file, err)
+		}
+
+		if header.Typeflag == tar.TypeReg && isMember(header.Name, member) {
+			return archive, closeAll, nil
+		}
+	}
+
+	closeAll()
+	return nil, nil, errors.Errorf("%s is not in the archive", member)
+}
+
+func openZip(file, member string) (io.Reader, func(), error) {
+	archive, err := zip.OpenReader(file)
+	if err != nil {
+		return nil, nil, errors.Errorf("Failed to read %s: %s", file, err)
+	}
+
+	for _, entry := range archive.File {
+		if entry.FileInfo().IsDir() || !isMember(entry.Name, member) {
+			continue
+		}
+
+		r, err := entry.Open()
+		if err != nil {
+			archive.Close()
+			return nil, nil, errors.Errorf("Failed to read %s from %s: %s", member, file, err)
+		}
+
+		return r, func() {
+			r.Close()
+			archive.Close()
+		}, nil
+	}
+
+	archive.Close()
+	return nil, nil, errors.Errorf("%s is not in the archive", member)
+}
+
+// isMember reports whether the archive entry name is member, or has its name
+// when member has no directory.
+func isMember(name, member string) bool {
+	if !strings.Contains(member, "/") {
+		return path.Base(name) == member
+	}
+
+	return path.Clean(name) == path.Clean(member)
+}
+
+func fileSha256(file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,189 @@
+package download
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type downloadSuite struct {
+	suite.Suite
+	cacheDir string
+	files    map[string][]byte
+	requests int
+	server   *httptest.Server
+}
+
+func (s *downloadSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *downloadSuite) SetupTest() {
+	s.cacheDir = filepath.Join(s.T().TempDir(), "cache")
+	s.files = map[string][]byte{}
+	s.requests = 0
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.requests++
+		content, found := s.files[r.URL.Path]
+		if !found {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(content)
+	}))
+}
+
+func (s *downloadSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *downloadSuite) newClient() *client {
+	return newClientWithComponents(s.cacheDir, s.server.Client(), filesystem.New())
+}
+
+func checksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func tarGz(files map[string]string) []byte {
+	b := &bytes.Buffer{}
+	gz := gzip.NewWriter(b)
+	archive := tar.NewWriter(gz)
+	for name, content := range files {
+		_ = archive.WriteHeader(&tar.Header{Name: name, Mode: 0755, Size: int64(len(content)), Typeflag: tar.TypeReg})
+		_, _ = archive.Write([]byte(content))
+	}
+	archive.Close()
+	gz.Close()
+
+	return b.Bytes()
+}
+
+func zipArchive(files map[string]string) []byte {
+	b := &bytes.Buffer{}
+	archive := zip.NewWriter(b)
+	for name, content := range files {
+		w, _ := archive.Create(name)
+		_, _ = w.Write([]byte(content))
+	}
+	archive.Close()
+
+	return b.Bytes()
+}
+
+func (s *downloadSuite) TestFetchCaches() {
+	s.files["/overmind"] = []byte("#!/bin/sh\necho overmind\n")
+	client := s.newClient()
+
+	path, err := client.Fetch(s.server.URL+"/overmind", checksum(s.files["/overmind"]))
+	s.Require().NoError(err)
+	s.Require().Equal(filepath.Join(s.cacheDir, checksum(s.files["/overmind"])), path)
+
+	path, err = client.Fetch(s.server.URL+"/overmind", checksum(s.files["/overmind"]))
+	s.Require().NoError(err)
+	s.Require().FileExists(path)
+	s.Require().Equal(1, s.requests)
+}
+
+func (s *downloadSuite) TestFetchUnverified() {
+	s.files["/overmind"] = []byte("#!/bin/sh\necho overmind\n")
+	client := s.newClient()
+
+	path, err := client.Fetch(s.server.URL+"/overmind", "")
+	s.Require().NoError(err)
+	s.Require().Equal(filepath.Join(s.cacheDir, checksum(s.files["/overmind"])), path)
+
+	_, err = client.Fetch(s.server.URL+"/overmind", "")
+	s.Require().NoError(err)
+	s.Require().Equal(2, s.requests)
+}
+
+func (s *downloadSuite) TestFetchErrors() {
+	s.files["/overmind"] = []byte("tampered")
+	client := s.newClient()
+	expected := checksum([]byte("original"))
+
+	_, err := client.Fetch(s.server.URL+"/overmind", expected)
+	s.Require().EqualError(err, "Checksum mismatch for "+s.server.URL+"/overmind: expected sha256 "+expected+", got "+checksum(s.files["/overmind"]))
+	s.Require().NoFileExists(filepath.Join(s.cacheDir, expected))
+
+	_, err = client.Fetch(s.server.URL+"/missing", expected)
+	s.Require().EqualError(err, "Failed to download "+s.server.URL+"/missing: 404 Not Found")
+
+	names, err := filesystem.New().ListDir(s.cacheDir)
+	s.Require().NoError(err)
+	s.Require().Empty(names)
+}
+
+func (s *downloadSuite) TestFetchWith() {
+	content := []byte("#!/bin/sh\necho tool\n")
+	opened := 0
+	open := func() (io.ReadCloser, error) {
+		opened++
+		return io.NopCloser(bytes.NewReader(content)), nil
+	}
+	client := s.newClient()
+
+	path, err := client.FetchWith("tool", checksum(content), open)
+	s.Require().NoError(err)
+	s.Require().Equal(filepath.Join(s.cacheDir, checksum(content)), path)
+
+	_, err = client.FetchWith("tool", checksum(content), open)
+	s.Require().NoError(err)
+	s.Require().Equal(1, opened)
+
+	_, err = client.FetchWith("other", checksum([]byte("other")), func() (io.ReadCloser, error) {
+		return nil, errors.New("Asset other: not found")
+	})
+	s.Require().EqualError(err, "Asset other: not found")
+	s.Require().Equal(0, s.requests)
+}
+
+func (s *downloadSuite) TestInstall() {
+	dir := s.T().TempDir()
+	client := s.newClient()
+
+	for format, content := range map[string][]byte{
+		FormatTarGz: tarGz(map[string]string{"overmind-v2.5.1/README.md": "docs", "overmind-v2.5.1/overmind": "binary"}),
+		FormatZip:   zipArchive(map[string]string{"overmind-v2.5.1/overmind": "binary"}),
+	} {
+		archive := filepath.Join(dir, "archive."+format)
+		s.Require().NoError(os.WriteFile(archive, content, 0644))
+		dest := filepath.Join(dir, "overmind-"+format)
+
+		s.Require().NoError(client.Install(archive, format, "overmind-v2.5.1/overmind", dest))
+
+		installed, err := os.ReadFile(dest)
+		s.Require().NoError(err)
+		s.Require().Equal("binary", string(installed))
+		s.Require().True(filesystem.New().IsExecutable(dest))
+
+		s.Require().EqualError(client.Install(archive, format, "v2.5.1/overmind", dest), "v2.5.1/overmind is not in the archive")
+		s.Require().NoError(client.Install(archive, format, "overmind", dest))
+	}
+
+	raw := filepath.Join(dir, "raw")
+	s.Require().NoError(os.WriteFile(raw, []byte("raw binary"), 0644))
+	s.Require().NoError(client.Install(raw, "", "", filepath.Join(dir, "tool")))
+	s.Require().True(filesystem.New().IsExecutable(filepath.Join(dir, "tool")))
+}
+
+func TestDownloadSuite(t *testing.T) {
+	suite.Run(t, new(downloadSuite))
+}
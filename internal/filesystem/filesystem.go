@@ -33,9 +33,15 @@ type Client interface {
 	MkdirTemp() (string, error)
 	EqualFiles(source, destination string) (bool, error)
 	WriteString(path, content string) error
+	WritePrivateString(path, content string) error
 	AppendString(path, content string) error
 	MkdirAll(path string) error
 	ReadString(path string) (string, error)
+	Rename(source, destination string) error
+	ListDir(path string) ([]string, error)
+	Symlink(target, link string) error
+	Readlink(path string) (string, error)
+	WriteStringAtomic(path, content string) error
 }
 
 type UserInfo struct {
@@ -306,6 +312,25 @@ func (c *client) WriteString(path, content string) error {
 	return err
 }
 
+// WritePrivateString writes the content to a file only readable by its owner,
+// for keys and secrets. An existing file is overwritten and its permissions
+// are reset.
+func (c *client) WritePrivateString(path, content string) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	if err := f.Chmod(0600); err != nil {
+		return err
+	}
+
+	_, err = f.WriteString(content)
+	return err
+}
+
 func (c *client) MkdirAll(path string) error {
 	if c.Exists(path) && !c.IsDir(path) {
 		return errors.Errorf("Path %s exists and is not a directory", path)
@@ -321,3 +346,92 @@ func (c *client) ReadString(path string) (string, error) {
 
 	return string(content), nil
 }
+
+func (c *client) Rename(source, destination string) error {
+	if err := os.Rename(source, destination); err != nil {
+		return errors.Errorf("Failed to move %s to %s: %s", source, destination, err)
+	}
+
+	return nil
+}
+
+// ListDir returns the names of the entries of a directory, sorted.
+func (c *client) ListDir(path string) ([]string, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, errors.Errorf("Failed to list %s: %s", path, err)
+	}
+
+	names := []string{}
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+
+	return names, nil
+}
+
+// Symlink creates link pointing to target. Under sudo, the link is given to
+// the user running sudo, as EnsureNonSudoOwnership does for files.
+func (c *client) Symlink(target, link string) error {
+	if err := os.Symlink(target, link); err != nil {
+		return errors.Errorf("Failed to link %s to %s: %s", link, target, err)
+	}
+
+	if !c.sys.IsSudo() {
+		return nil
+	}
+
+	user, err := c.sys.GetSudoOriginalUser()
+	if err != nil {
+		return err
+	}
+	log.Debugf("Setting ownership of %s link to %s", link, user.Name)
+
+	// Lchown, since Chown would change the owner of the target
+	if err := os.Lchown(link, user.Id, -1); err != nil {
+		return errors.Errorf("Failed to change the owner of %s: %s", link, err)
+	}
+
+	return nil
+}
+
+func (c *client) Readlink(path string) (string, error) {
+	target, err := os.Readlink(path)
+	if err != nil {
+		return "", errors.Errorf("Failed to read link %s: %s", path, err)
+	}
+
+	return target, nil
+}
+
+// WriteStringAtomic writes the content to a temporary file next to path, then
+// renames it, so that readers never see a partial file. The mode of an
+// existing file is kept.
+func (c *client) WriteStringAtomic(path, content string) error {
+	mode := os.FileMode(0644)
+	if info, err := os.Stat(path); err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return errors.Errorf("Failed to create a temporary file for %s: %s", path, err)
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.WriteString(content); err != nil {
+		f.Close()
+		return errors.Errorf("Failed to write %s: %s", f.Name(), err)
+	}
+
+	if err := f.Chmod(mode); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return c.Rename(f.Name(), path)
+}
@@ -9,6 +9,8 @@ import (
 	"syscall"
 	"testing"
 
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+	"github.com/renegumroad/gum-cli/internal/systeminfo/mocksysteminfo"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -115,6 +117,27 @@ func (s *filesystemSuite) TestWriteString() {
 	s.Require().Equal(newContent, string(readContent), "Overwritten content should match the new content")
 }
 
+func (s *filesystemSuite) TestWritePrivateString() {
+	c := New()
+	tmpDir, err := c.MkdirTemp()
+	s.Require().NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	filePath := filepath.Join(tmpDir, "key.pem")
+	s.Require().NoError(os.WriteFile(filePath, []byte("old"), 0644))
+
+	err = c.WritePrivateString(filePath, "secret")
+	s.Require().NoError(err)
+
+	info, err := os.Stat(filePath)
+	s.Require().NoError(err)
+	s.Require().Equal(os.FileMode(0600), info.Mode().Perm())
+
+	readContent, err := os.ReadFile(filePath)
+	s.Require().NoError(err)
+	s.Require().Equal("secret", string(readContent))
+}
+
 func (s *filesystemSuite) TestGetOwner() {
 	c := New()
 	tmpDir, err := c.MkdirTemp()
@@ -370,6 +393,107 @@ func (s *filesystemSuite) TestMkdirAllWithFileAsPath() {
 	s.Require().Error(err)
 }
 
+func (s *filesystemSuite) TestRenameKeepsMode() {
+	c := New()
+	tempDir, err := c.MkdirTemp()
+	s.Require().NoError(err)
+	defer os.RemoveAll(tempDir)
+
+	source := filepath.Join(tempDir, "pre-commit")
+	destination := filepath.Join(tempDir, "pre-commit.local")
+	s.Require().NoError(os.WriteFile(source, []byte("#!/bin/sh\n"), 0755))
+
+	s.Require().NoError(c.Rename(source, destination))
+
+	s.Require().False(c.Exists(source))
+	s.Require().True(c.IsExecutable(destination))
+}
+
+func (s *filesystemSuite) TestListDir() {
+	c := New()
+	tempDir, err := c.MkdirTemp()
+	s.Require().NoError(err)
+	defer os.RemoveAll(tempDir)
+
+	s.Require().NoError(os.WriteFile(filepath.Join(tempDir, "pre-push"), []byte(""), 0644))
+	s.Require().NoError(os.WriteFile(filepath.Join(tempDir, "commit-msg"), []byte(""), 0644))
+
+	names, err := c.ListDir(tempDir)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"commit-msg", "pre-push"}, names)
+
+	_, err = c.ListDir(filepath.Join(tempDir, "missing"))
+	s.Require().Error(err)
+}
+
+func (s *filesystemSuite) TestSymlink() {
+	c := New()
+	tempDir, err := c.MkdirTemp()
+	s.Require().NoError(err)
+	defer os.RemoveAll(tempDir)
+
+	target := filepath.Join(tempDir, "irbrc")
+	link := filepath.Join(tempDir, ".irbrc")
+	s.Require().NoError(os.WriteFile(target, []byte("IRB.conf"), 0644))
+
+	s.Require().NoError(c.Symlink(target, link))
+
+	s.Require().True(c.IsSymlink(link))
+	readTarget, err := c.Readlink(link)
+	s.Require().NoError(err)
+	s.Require().Equal(target, readTarget)
+
+	s.Require().Error(c.Symlink(target, link))
+	_, err = c.Readlink(target)
+	s.Require().Error(err)
+}
+
+func (s *filesystemSuite) TestSymlinkUnderSudo() {
+	current, err := user.Current()
+	s.Require().NoError(err)
+	uid, err := strconv.Atoi(current.Uid)
+	s.Require().NoError(err)
+
+	sys := mocksysteminfo.NewMockClient(s.T())
+	sys.EXPECT().IsSudo().Return(true)
+	sys.EXPECT().GetSudoOriginalUser().Return(&systeminfo.UserInfo{Id: uid, Name: current.Username}, nil)
+	c := newClientWithComponents(sys)
+
+	tempDir := s.T().TempDir()
+	link := filepath.Join(tempDir, ".psqlrc")
+
+	s.Require().NoError(c.Symlink(filepath.Join(tempDir, "psqlrc"), link))
+
+	info, err := os.Lstat(link)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(uid), info.Sys().(*syscall.Stat_t).Uid)
+}
+
+func (s *filesystemSuite) TestWriteStringAtomic() {
+	c := New()
+	tempDir := s.T().TempDir()
+	path := filepath.Join(tempDir, "database.yml")
+
+	s.Require().NoError(c.WriteStringAtomic(path, "development:\n"))
+	content, err := c.ReadString(path)
+	s.Require().NoError(err)
+	s.Require().Equal("development:\n", content)
+
+	s.Require().NoError(os.Chmod(path, 0600))
+	s.Require().NoError(c.WriteStringAtomic(path, "test:\n"))
+	content, err = c.ReadString(path)
+	s.Require().NoError(err)
+	s.Require().Equal("test:\n", content)
+
+	info, err := os.Stat(path)
+	s.Require().NoError(err)
+	s.Require().Equal(os.FileMode(0600), info.Mode().Perm())
+
+	names, err := c.ListDir(tempDir)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"database.yml"}, names)
+}
+
 func TestFileSystemSuite(t *testing.T) {
 	suite.Run(t, new(filesystemSuite))
 }
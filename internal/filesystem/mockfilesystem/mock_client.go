@@ -710,6 +710,64 @@ func (_c *MockClient_IsSymlink_Call) RunAndReturn(run func(string) bool) *MockCl
 	return _c
 }
 
+// ListDir provides a mock function with given fields: path
+func (_m *MockClient) ListDir(path string) ([]string, error) {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDir")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return rf(path)
+	}
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_ListDir_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDir'
+type MockClient_ListDir_Call struct {
+	*mock.Call
+}
+
+// ListDir is a helper method to define mock.On call
+//   - path string
+func (_e *MockClient_Expecter) ListDir(path interface{}) *MockClient_ListDir_Call {
+	return &MockClient_ListDir_Call{Call: _e.mock.On("ListDir", path)}
+}
+
+func (_c *MockClient_ListDir_Call) Run(run func(path string)) *MockClient_ListDir_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_ListDir_Call) Return(_a0 []string, _a1 error) *MockClient_ListDir_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClient_ListDir_Call) RunAndReturn(run func(string) ([]string, error)) *MockClient_ListDir_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MakeExecutable provides a mock function with given fields: path
 func (_m *MockClient) MakeExecutable(path string) error {
 	ret := _m.Called(path)
@@ -913,6 +971,109 @@ func (_c *MockClient_ReadString_Call) RunAndReturn(run func(string) (string, err
 	return _c
 }
 
+// Readlink provides a mock function with given fields: path
+func (_m *MockClient) Readlink(path string) (string, error) {
+	ret := _m.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Readlink")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(path)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(path)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_Readlink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Readlink'
+type MockClient_Readlink_Call struct {
+	*mock.Call
+}
+
+// Readlink is a helper method to define mock.On call
+//   - path string
+func (_e *MockClient_Expecter) Readlink(path interface{}) *MockClient_Readlink_Call {
+	return &MockClient_Readlink_Call{Call: _e.mock.On("Readlink", path)}
+}
+
+func (_c *MockClient_Readlink_Call) Run(run func(path string)) *MockClient_Readlink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_Readlink_Call) Return(_a0 string, _a1 error) *MockClient_Readlink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClient_Readlink_Call) RunAndReturn(run func(string) (string, error)) *MockClient_Readlink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rename provides a mock function with given fields: source, destination
+func (_m *MockClient) Rename(source string, destination string) error {
+	ret := _m.Called(source, destination)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rename")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(source, destination)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClient_Rename_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rename'
+type MockClient_Rename_Call struct {
+	*mock.Call
+}
+
+// Rename is a helper method to define mock.On call
+//   - source string
+//   - destination string
+func (_e *MockClient_Expecter) Rename(source interface{}, destination interface{}) *MockClient_Rename_Call {
+	return &MockClient_Rename_Call{Call: _e.mock.On("Rename", source, destination)}
+}
+
+func (_c *MockClient_Rename_Call) Run(run func(source string, destination string)) *MockClient_Rename_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_Rename_Call) Return(_a0 error) *MockClient_Rename_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClient_Rename_Call) RunAndReturn(run func(string, string) error) *MockClient_Rename_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RootDir provides a mock function with given fields:
 func (_m *MockClient) RootDir() string {
 	ret := _m.Called()
@@ -958,6 +1119,100 @@ func (_c *MockClient_RootDir_Call) RunAndReturn(run func() string) *MockClient_R
 	return _c
 }
 
+// Symlink provides a mock function with given fields: target, link
+func (_m *MockClient) Symlink(target string, link string) error {
+	ret := _m.Called(target, link)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Symlink")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(target, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClient_Symlink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Symlink'
+type MockClient_Symlink_Call struct {
+	*mock.Call
+}
+
+// Symlink is a helper method to define mock.On call
+//   - target string
+//   - link string
+func (_e *MockClient_Expecter) Symlink(target interface{}, link interface{}) *MockClient_Symlink_Call {
+	return &MockClient_Symlink_Call{Call: _e.mock.On("Symlink", target, link)}
+}
+
+func (_c *MockClient_Symlink_Call) Run(run func(target string, link string)) *MockClient_Symlink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_Symlink_Call) Return(_a0 error) *MockClient_Symlink_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClient_Symlink_Call) RunAndReturn(run func(string, string) error) *MockClient_Symlink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WritePrivateString provides a mock function with given fields: path, content
+func (_m *MockClient) WritePrivateString(path string, content string) error {
+	ret := _m.Called(path, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WritePrivateString")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(path, content)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClient_WritePrivateString_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WritePrivateString'
+type MockClient_WritePrivateString_Call struct {
+	*mock.Call
+}
+
+// WritePrivateString is a helper method to define mock.On call
+//   - path string
+//   - content string
+func (_e *MockClient_Expecter) WritePrivateString(path interface{}, content interface{}) *MockClient_WritePrivateString_Call {
+	return &MockClient_WritePrivateString_Call{Call: _e.mock.On("WritePrivateString", path, content)}
+}
+
+func (_c *MockClient_WritePrivateString_Call) Run(run func(path string, content string)) *MockClient_WritePrivateString_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_WritePrivateString_Call) Return(_a0 error) *MockClient_WritePrivateString_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClient_WritePrivateString_Call) RunAndReturn(run func(string, string) error) *MockClient_WritePrivateString_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // WriteString provides a mock function with given fields: path, content
 func (_m *MockClient) WriteString(path string, content string) error {
 	ret := _m.Called(path, content)
@@ -1005,6 +1260,53 @@ func (_c *MockClient_WriteString_Call) RunAndReturn(run func(string, string) err
 	return _c
 }
 
+// WriteStringAtomic provides a mock function with given fields: path, content
+func (_m *MockClient) WriteStringAtomic(path string, content string) error {
+	ret := _m.Called(path, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WriteStringAtomic")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(path, content)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockClient_WriteStringAtomic_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WriteStringAtomic'
+type MockClient_WriteStringAtomic_Call struct {
+	*mock.Call
+}
+
+// WriteStringAtomic is a helper method to define mock.On call
+//   - path string
+//   - content string
+func (_e *MockClient_Expecter) WriteStringAtomic(path interface{}, content interface{}) *MockClient_WriteStringAtomic_Call {
+	return &MockClient_WriteStringAtomic_Call{Call: _e.mock.On("WriteStringAtomic", path, content)}
+}
+
+func (_c *MockClient_WriteStringAtomic_Call) Run(run func(path string, content string)) *MockClient_WriteStringAtomic_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_WriteStringAtomic_Call) Return(_a0 error) *MockClient_WriteStringAtomic_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockClient_WriteStringAtomic_Call) RunAndReturn(run func(string, string) error) *MockClient_WriteStringAtomic_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockClient creates a new instance of MockClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockClient(t interface {
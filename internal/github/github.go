@@ -0,0 +1,184 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/userconfig"
+)
+
+const DefaultAPIURL = "https://api.github.com"
+
+// Release is a GitHub release and its assets.
+type Release struct {
+	TagName string  `json:"tag_name"`
+	Assets  []Asset `json:"assets"`
+}
+
+// Asset is a file of a release. URL is the public download URL, while
+// APIURL downloads it with the token, which private repositories require.
+// Digest is sha256:<hex> on recent releases.
+type Asset struct {
+	Name   string `json:"name"`
+	URL    string `json:"browser_download_url"`
+	APIURL string `json:"url"`
+	Digest string `json:"digest,omitempty"`
+}
+
+// Client reads releases from the GitHub API. Requests are authenticated with
+// GITHUB_TOKEN when it is set, for private repositories and rate limits.
+type Client interface {
+	// Release returns the release of owner/repo tagged version, or the
+	// latest release when version is empty. A version without the v prefix
+	// also matches a v tag.
+	Release(repo, version string) (*Release, error)
+	// ReadAsset returns the content of a small asset, such as a checksums
+	// file.
+	ReadAsset(asset *Asset) ([]byte, error)
+	// OpenAsset starts the download of an asset, to be closed by the caller.
+	OpenAsset(asset *Asset) (io.ReadCloser, error)
+}
+
+type client struct {
+	apiURL string
+	token  string
+	http   *http.Client
+}
+
+func New() (Client, error) {
+	config, err := userconfig.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := config.GitHubAPIURL
+	if apiURL == "" {
+		apiURL = DefaultAPIURL
+	}
+
+	if _, err := url.ParseRequestURI(apiURL); err != nil {
+		return nil, errors.Errorf("Invalid github_api_url %s in ~/.gum/config.yml: %s", apiURL, err)
+	}
+
+	return newClientWithComponents(apiURL, os.Getenv("GITHUB_TOKEN"), http.DefaultClient), nil
+}
+
+func newClientWithComponents(apiURL, token string, httpClient *http.Client) *client {
+	return &client{
+		apiURL: strings.TrimSuffix(apiURL, "/"),
+		token:  token,
+		http:   httpClient,
+	}
+}
+
+func (c *client) Release(repo, version string) (*Release, error) {
+	if version == "" {
+		return c.release(repo, "latest", "releases/latest")
+	}
+
+	release, err := c.release(repo, version, "releases/tags/"+url.PathEscape(version))
+	if errors.Is(err, errNotFound) && !strings.HasPrefix(version, "v") {
+		return c.release(repo, version, "releases/tags/"+url.PathEscape("v"+version))
+	}
+
+	return release, err
+}
+
+var errNotFound = errors.New("not found")
+
+func (c *client) release(repo, version, path string) (*Release, error) {
+	body, err := c.get(fmt.Sprintf("%s/repos/%s/%s", c.apiURL, repo, path), "application/vnd.github+json")
+	if errors.Is(err, errNotFound) {
+		return nil, errors.Wrapf(errNotFound, "Release %s of %s", version, repo)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	release := &Release{}
+	if err := json.Unmarshal(body, release); err != nil {
+		return nil, errors.Errorf("Failed to read the %s release of %s: %s", version, repo, err)
+	}
+
+	return release, nil
+}
+
+func (c *client) ReadAsset(asset *Asset) ([]byte, error) {
+	body, err := c.OpenAsset(asset)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return nil, errors.Errorf("Failed to get %s: %s", asset.APIURL, err)
+	}
+
+	return content, nil
+}
+
+// OpenAsset downloads through the API, which redirects to the file, since
+// browser_download_url doesn't accept the token.
+func (c *client) OpenAsset(asset *Asset) (io.ReadCloser, error) {
+	body, err := c.open(asset.APIURL, "application/octet-stream")
+	if errors.Is(err, errNotFound) {
+		return nil, errors.Wrapf(errNotFound, "Asset %s", asset.Name)
+	}
+
+	return body, err
+}
+
+func (c *client) get(rawURL, accept string) ([]byte, error) {
+	body, err := c.open(rawURL, accept)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return nil, errors.Errorf("Failed to get %s: %s", rawURL, err)
+	}
+
+	return content, nil
+}
+
+func (c *client) open(rawURL, accept string) (io.ReadCloser, error) {
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", accept)
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	log.Debugf("GET %s", rawURL)
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, errors.Errorf("Failed to get %s: %s", rawURL, err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return resp.Body, nil
+	case resp.StatusCode == http.StatusNotFound:
+		err = errNotFound
+	case resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests:
+		err = errors.Errorf("Failed to get %s: %s. Set GITHUB_TOKEN to raise the GitHub API rate limit", rawURL, resp.Status)
+	default:
+		err = errors.Errorf("Failed to get %s: %s", rawURL, resp.Status)
+	}
+
+	resp.Body.Close()
+	return nil, err
+}
@@ -0,0 +1,131 @@
+package github
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type githubSuite struct {
+	suite.Suite
+	responses map[string]string
+	redirects map[string]string
+	headers   http.Header
+	// token is required by the server when set, which hides the private
+	// repositories from other requests as GitHub does.
+	token  string
+	server *httptest.Server
+}
+
+func (s *githubSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *githubSuite) SetupTest() {
+	s.responses = map[string]string{}
+	s.redirects = map[string]string{}
+	s.token = ""
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if target, found := s.redirects[r.URL.Path]; found {
+			if s.token != "" && r.Header.Get("Authorization") != "Bearer "+s.token {
+				http.NotFound(w, r)
+				return
+			}
+			if r.Header.Get("Accept") != "application/octet-stream" {
+				_, _ = w.Write([]byte(`{"name": "asset"}`))
+				return
+			}
+			http.Redirect(w, r, target, http.StatusFound)
+			return
+		}
+
+		s.headers = r.Header
+		body, found := s.responses[r.URL.Path]
+		if !found {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+}
+
+func (s *githubSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *githubSuite) newClient(token string) *client {
+	return newClientWithComponents(s.server.URL+"/", token, s.server.Client())
+}
+
+func (s *githubSuite) TestLatestRelease() {
+	s.responses["/repos/gumroad/tool/releases/latest"] = `{
+		"tag_name": "v1.2.0",
+		"assets": [{"name": "tool_linux_amd64.tar.gz", "browser_download_url": "https://example.com/tool.tar.gz", "url": "https://api.example.com/assets/1", "digest": "sha256:abc"}]
+	}`
+
+	release, err := s.newClient("secret").Release("gumroad/tool", "")
+
+	s.Require().NoError(err)
+	s.Require().Equal(&Release{
+		TagName: "v1.2.0",
+		Assets:  []Asset{{Name: "tool_linux_amd64.tar.gz", URL: "https://example.com/tool.tar.gz", APIURL: "https://api.example.com/assets/1", Digest: "sha256:abc"}},
+	}, release)
+	s.Require().Equal("Bearer secret", s.headers.Get("Authorization"))
+	s.Require().Equal("application/vnd.github+json", s.headers.Get("Accept"))
+}
+
+func (s *githubSuite) TestTaggedRelease() {
+	s.responses["/repos/gumroad/tool/releases/tags/v1.1.0"] = `{"tag_name": "v1.1.0"}`
+	client := s.newClient("")
+
+	release, err := client.Release("gumroad/tool", "1.1.0")
+	s.Require().NoError(err)
+	s.Require().Equal("v1.1.0", release.TagName)
+	s.Require().Empty(s.headers.Get("Authorization"))
+
+	_, err = client.Release("gumroad/tool", "1.0.0")
+	s.Require().EqualError(err, "Release 1.0.0 of gumroad/tool: not found")
+}
+
+func (s *githubSuite) TestReadAsset() {
+	s.redirects["/repos/gumroad/tool/releases/assets/1"] = "/storage/checksums.txt"
+	s.responses["/storage/checksums.txt"] = "abc  tool_linux_amd64.tar.gz\n"
+	client := s.newClient("")
+
+	content, err := client.ReadAsset(&Asset{Name: "checksums.txt", APIURL: s.server.URL + "/repos/gumroad/tool/releases/assets/1"})
+	s.Require().NoError(err)
+	s.Require().Equal("abc  tool_linux_amd64.tar.gz\n", string(content))
+
+	_, err = client.ReadAsset(&Asset{Name: "missing.txt", APIURL: s.server.URL + "/repos/gumroad/tool/releases/assets/2"})
+	s.Require().EqualError(err, "Asset missing.txt: not found")
+}
+
+func (s *githubSuite) TestOpenPrivateAsset() {
+	s.token = "secret"
+	s.redirects["/repos/gumroad/private/releases/assets/7"] = "/storage/tool_linux_amd64.tar.gz"
+	s.responses["/storage/tool_linux_amd64.tar.gz"] = "archive"
+	asset := &Asset{
+		Name:   "tool_linux_amd64.tar.gz",
+		URL:    s.server.URL + "/gumroad/private/releases/download/v1.0.0/tool_linux_amd64.tar.gz",
+		APIURL: s.server.URL + "/repos/gumroad/private/releases/assets/7",
+	}
+
+	body, err := s.newClient("secret").OpenAsset(asset)
+	s.Require().NoError(err)
+	defer body.Close()
+	content, err := io.ReadAll(body)
+	s.Require().NoError(err)
+	s.Require().Equal("archive", string(content))
+
+	_, err = s.newClient("").OpenAsset(asset)
+	s.Require().EqualError(err, "Asset tool_linux_amd64.tar.gz: not found")
+}
+
+func TestGithubSuite(t *testing.T) {
+	suite.Run(t, new(githubSuite))
+}
@@ -2,12 +2,15 @@ package gumconfig
 
 import (
 	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/renegumroad/gum-cli/internal/actions"
 	"github.com/renegumroad/gum-cli/internal/cli/homebrew"
 	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/hostsfile"
 	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/secrets"
 	"github.com/renegumroad/gum-cli/internal/yaml"
 )
 
@@ -20,11 +23,27 @@ var (
 
 type GumConfig struct {
 	Up []UpAction `yaml:"up,omitempty"`
+	// Secrets maps env var names to references resolved by a secrets
+	// provider, such as op://vault/item/field.
+	Secrets map[string]string `yaml:"secrets,omitempty"`
 }
 
 type UpAction struct {
-	Action NamedAction        `yaml:"action,omitempty"`
-	Brew   []homebrew.Package `yaml:"brew,omitempty"`
+	Action         NamedAction                 `yaml:"action,omitempty"`
+	With           map[string]interface{}      `yaml:"with,omitempty"`
+	Brew           []homebrew.Package          `yaml:"brew,omitempty"`
+	Services       []actions.ServiceArgs       `yaml:"services,omitempty"`
+	SystemPackages *actions.SystemPackagesArgs `yaml:"system_packages,omitempty"`
+	Database       *actions.DatabaseArgs       `yaml:"database,omitempty"`
+	Certs          *actions.CertsArgs          `yaml:"certs,omitempty"`
+	Hosts          []hostsfile.Entry           `yaml:"hosts,omitempty"`
+	Dotenv         *actions.DotenvArgs         `yaml:"dotenv,omitempty"`
+	GitHooks       *actions.GitHooksArgs       `yaml:"git_hooks,omitempty"`
+	Repos          []actions.RepoArgs          `yaml:"repos,omitempty"`
+	Links          *actions.LinksArgs          `yaml:"links,omitempty"`
+	Template       *actions.TemplateArgs       `yaml:"template,omitempty"`
+	Download       *actions.DownloadArgs       `yaml:"download,omitempty"`
+	GitHubRelease  *actions.GitHubReleaseArgs  `yaml:"github_release,omitempty"`
 }
 
 type NamedAction string
@@ -41,17 +60,29 @@ func New(dir string) (*GumConfig, error) {
 func (config *GumConfig) Validate() error {
 	log.Debugf("Validating gum config")
 
+	if err := secrets.Validate(config.Secrets); err != nil {
+		return err
+	}
+
+	gitHooks := 0
 	for _, up := range config.Up {
-		if up.Action == "" && len(up.Brew) == 0 {
-			return errors.Errorf("Named action or brew packages are required")
-		} else if up.Action != "" && len(up.Brew) > 0 {
-			return errors.Errorf("Cannot defined a named action and brew packages in the same entry")
+		kinds := up.kinds()
+		if len(kinds) == 0 {
+			return errors.Errorf("Named action, brew packages, services, system packages, a database, certs, hosts, dotenv, git hooks, repos, links, a template, a download or a GitHub release are required")
+		} else if len(kinds) > 1 {
+			return errors.Errorf("Cannot define %s in the same entry", strings.Join(kinds, " and "))
 		}
 
 		if up.Action != "" {
 			if !actions.SupportedByConfig(string(up.Action)) {
 				return errors.Errorf("Named action %s does not exist or cannot be invoked via config", up.Action)
 			}
+
+			if err := actions.ValidateParams(string(up.Action), up.With); err != nil {
+				return err
+			}
+		} else if len(up.With) > 0 {
+			return errors.Errorf("Parameters with: can only be set on a named action")
 		}
 
 		for _, pkg := range up.Brew {
@@ -60,12 +91,125 @@ func (config *GumConfig) Validate() error {
 			}
 		}
 
+		for _, svc := range up.Services {
+			if svc.Name == "" {
+				return errors.Errorf("Service name is required")
+			}
+		}
+
+		if up.SystemPackages != nil && up.SystemPackages.IsEmpty() {
+			return errors.Errorf("System packages require at least one apt, dnf or pacman package")
+		}
+
+		if up.Database != nil && up.Database.Name == "" {
+			return errors.Errorf("Database name is required")
+		}
+
+		if up.Certs != nil && len(up.Certs.Hosts) == 0 {
+			return errors.Errorf("Certs require at least one host")
+		}
+
+		for _, entry := range up.Hosts {
+			if err := entry.Validate(); err != nil {
+				return err
+			}
+		}
+
+		for _, repo := range up.Repos {
+			if repo.Name == "" {
+				return errors.Errorf("Repository name is required")
+			}
+		}
+
+		if up.Links != nil && len(up.Links.Files) == 0 {
+			return errors.Errorf("Links require at least one file")
+		}
+
+		if up.Template != nil && up.Template.Output == "" {
+			return errors.Errorf("Template output is required")
+		}
+
+		if up.Download != nil && up.Download.Name == "" {
+			return errors.Errorf("Download name is required")
+		}
+
+		if up.GitHubRelease != nil && up.GitHubRelease.Repo == "" {
+			return errors.Errorf("GitHub release repo is required")
+		}
+
+		if up.GitHooks != nil {
+			if gitHooks++; gitHooks > 1 {
+				return errors.Errorf("Only one git_hooks entry is supported")
+			}
+		}
 	}
 
 	log.Infoln("gum.yml config validated successfully")
 	return nil
 }
 
+// kinds returns the entry types set in an up entry. Exactly one is expected.
+func (up UpAction) kinds() []string {
+	kinds := []string{}
+
+	if up.Action != "" {
+		kinds = append(kinds, "a named action")
+	}
+	if len(up.Brew) > 0 {
+		kinds = append(kinds, "brew packages")
+	}
+	if len(up.Services) > 0 {
+		kinds = append(kinds, "services")
+	}
+	if up.SystemPackages != nil {
+		kinds = append(kinds, "system packages")
+	}
+	if up.Database != nil {
+		kinds = append(kinds, "a database")
+	}
+	if up.Certs != nil {
+		kinds = append(kinds, "certs")
+	}
+	if len(up.Hosts) > 0 {
+		kinds = append(kinds, "hosts")
+	}
+	if up.Dotenv != nil {
+		kinds = append(kinds, "dotenv")
+	}
+	if up.GitHooks != nil {
+		kinds = append(kinds, "git hooks")
+	}
+	if len(up.Repos) > 0 {
+		kinds = append(kinds, "repos")
+	}
+	if up.Links != nil {
+		kinds = append(kinds, "links")
+	}
+	if up.Template != nil {
+		kinds = append(kinds, "a template")
+	}
+	if up.Download != nil {
+		kinds = append(kinds, "a download")
+	}
+	if up.GitHubRelease != nil {
+		kinds = append(kinds, "a GitHub release")
+	}
+
+	return kinds
+}
+
+// Exists reports whether dir has a gum config file.
+func Exists(dir string) bool {
+	fs := filesystem.New()
+	for _, fileName := range configFileNameOptions {
+		if fs.Exists(filepath.Join(dir, fileName)) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func findConfig(dir string) (*GumConfig, error) {
 	log.Debugf("Detecting gum config in %s", dir)
 	fs := filesystem.New()
@@ -0,0 +1,233 @@
+package hostsfile
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+)
+
+const DefaultPath = "/etc/hosts"
+
+var hostnameRe = regexp.MustCompile(`^(?i)[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*$`)
+
+// Entry maps an address to hostnames, as a line of the hosts file.
+type Entry struct {
+	IP    string   `yaml:"ip"`
+	Names []string `yaml:"names"`
+}
+
+// Validate checks that the entry can be written to the hosts file.
+func (e Entry) Validate() error {
+	if net.ParseIP(e.IP) == nil {
+		return errors.Errorf("Invalid IP address %q in hosts entry", e.IP)
+	}
+
+	if len(e.Names) == 0 {
+		return errors.Errorf("Hosts entry for %s requires at least one name", e.IP)
+	}
+
+	for _, name := range e.Names {
+		if !hostnameRe.MatchString(name) {
+			return errors.Errorf("Invalid hostname %q in hosts entry for %s", name, e.IP)
+		}
+	}
+
+	return nil
+}
+
+func (e Entry) String() string {
+	return e.IP + " " + strings.Join(e.Names, " ")
+}
+
+// Client manages blocks of entries in the hosts file, delimited by markers
+// naming their owner. Lines outside of the blocks are never changed.
+type Client interface {
+	Entries(owner string) ([]Entry, error)
+	Update(owner string, entries []Entry) error
+}
+
+type client struct {
+	path     string
+	fs       filesystem.Client
+	sys      systeminfo.Client
+	cmdGen   cmdexec.CmdGenerator
+	writable func(path string) bool
+}
+
+func New() Client {
+	return NewWithPath(DefaultPath)
+}
+
+// NewWithPath manages the blocks of the hosts file at path, such as a
+// temporary copy in tests.
+func NewWithPath(path string) Client {
+	return newClientWithComponents(path, filesystem.New(), systeminfo.New(), cmdexec.NewCommandGenerator(), isWritable)
+}
+
+func newClientWithComponents(
+	path string,
+	fs filesystem.Client,
+	sys systeminfo.Client,
+	gen cmdexec.CmdGenerator,
+	writable func(path string) bool,
+) *client {
+	return &client{
+		path:     path,
+		fs:       fs,
+		sys:      sys,
+		cmdGen:   gen,
+		writable: writable,
+	}
+}
+
+// Entries returns the entries of the block of owner.
+func (c *client) Entries(owner string) ([]Entry, error) {
+	content, err := c.fs.ReadString(c.path)
+	if err != nil {
+		return nil, err
+	}
+
+	lines, _, _ := findBlock(strings.Split(content, "\n"), owner)
+
+	entries := []Entry{}
+	for _, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		entries = append(entries, Entry{IP: fields[0], Names: fields[1:]})
+	}
+
+	return entries, nil
+}
+
+// Update replaces the block of owner with entries, removing it when there
+// are none. The file is only written when the block changes, with sudo when
+// it isn't writable.
+func (c *client) Update(owner string, entries []Entry) error {
+	for _, entry := range entries {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	content, err := c.fs.ReadString(c.path)
+	if err != nil {
+		return err
+	}
+
+	updated := replaceBlock(content, owner, entries)
+	if updated == content {
+		log.Debugf("Hosts entries of %s are up to date", owner)
+		return nil
+	}
+
+	log.Infof("Updating the hosts entries of %s in %s", owner, c.path)
+
+	if c.sys.IsSudo() || c.writable(c.path) {
+		return c.fs.WriteString(c.path, updated)
+	}
+
+	return c.writeWithSudo(updated)
+}
+
+// writeWithSudo copies the content over the hosts file, which keeps its
+// owner and permissions.
+func (c *client) writeWithSudo(content string) error {
+	dir, err := c.fs.MkdirTemp()
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	tmpPath := filepath.Join(dir, "hosts")
+	if err := c.fs.WriteString(tmpPath, content); err != nil {
+		return err
+	}
+
+	log.Infof("Elevated permissions are required to update %s", c.path)
+
+	cmd := c.cmdGen("sudo", "cp", tmpPath, c.path)
+	if err := cmd.Run(); err != nil {
+		return errors.Errorf("Failed to update %s: %s %s", c.path, err, cmd.Stderr())
+	}
+
+	return nil
+}
+
+func beginMarker(owner string) string {
+	return fmt.Sprintf("# BEGIN gum %s", owner)
+}
+
+func endMarker(owner string) string {
+	return fmt.Sprintf("# END gum %s", owner)
+}
+
+// findBlock returns the lines inside the block of owner and the indexes of
+// its markers, or -1 when there is no block.
+func findBlock(lines []string, owner string) ([]string, int, int) {
+	begin := slices.Index(lines, beginMarker(owner))
+	if begin < 0 {
+		return nil, -1, -1
+	}
+
+	end := slices.Index(lines[begin:], endMarker(owner))
+	if end < 0 {
+		return nil, -1, -1
+	}
+	end += begin
+
+	return lines[begin+1 : end], begin, end
+}
+
+func replaceBlock(content, owner string, entries []Entry) string {
+	lines := strings.Split(content, "\n")
+
+	block := []string{}
+	if len(entries) > 0 {
+		block = append(block, beginMarker(owner))
+		for _, entry := range entries {
+			block = append(block, entry.String())
+		}
+		block = append(block, endMarker(owner))
+	}
+
+	_, begin, end := findBlock(lines, owner)
+	if begin >= 0 {
+		lines = slices.Replace(lines, begin, end+1, block...)
+		return strings.Join(lines, "\n")
+	}
+
+	if len(block) == 0 {
+		return content
+	}
+
+	// append after the last line, keeping the trailing newline
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	lines = append(lines, block...)
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func isWritable(path string) bool {
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return false
+	}
+
+	f.Close()
+	return true
+}
@@ -0,0 +1,129 @@
+package hostsfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec/fakecmdexec"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo/mocksysteminfo"
+	"github.com/stretchr/testify/suite"
+)
+
+const hostsTestContent = `127.0.0.1	localhost
+::1	localhost
+# BEGIN gum /src/other
+127.0.0.1 other.gumroad.dev
+# END gum /src/other
+`
+
+type hostsfileSuite struct {
+	suite.Suite
+	dir     string
+	path    string
+	mockSys *mocksysteminfo.MockClient
+}
+
+func (s *hostsfileSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *hostsfileSuite) SetupTest() {
+	dir, err := filesystem.New().MkdirTemp()
+	s.Require().NoError(err)
+	s.dir = dir
+	s.path = filepath.Join(dir, "hosts")
+	s.Require().NoError(os.WriteFile(s.path, []byte(hostsTestContent), 0644))
+	s.mockSys = mocksysteminfo.NewMockClient(s.T())
+}
+
+func (s *hostsfileSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *hostsfileSuite) newClient(writable bool, cmds ...fakecmdexec.SettableCommand) *client {
+	return newClientWithComponents(s.path, filesystem.New(), s.mockSys, fakecmdexec.NewCmdGenerator(cmds...), func(_ string) bool {
+		return writable
+	})
+}
+
+func (s *hostsfileSuite) read() string {
+	content, err := os.ReadFile(s.path)
+	s.Require().NoError(err)
+
+	return string(content)
+}
+
+func (s *hostsfileSuite) TestUpdateAddsBlock() {
+	s.mockSys.EXPECT().IsSudo().Return(false)
+	client := s.newClient(true)
+
+	err := client.Update("/src/app", []Entry{{IP: "127.0.0.1", Names: []string{"app.gumroad.dev", "api.gumroad.dev"}}})
+
+	s.Require().NoError(err)
+	s.Require().Equal(hostsTestContent+"# BEGIN gum /src/app\n127.0.0.1 app.gumroad.dev api.gumroad.dev\n# END gum /src/app\n", s.read())
+
+	entries, err := client.Entries("/src/app")
+	s.Require().NoError(err)
+	s.Require().Equal([]Entry{{IP: "127.0.0.1", Names: []string{"app.gumroad.dev", "api.gumroad.dev"}}}, entries)
+}
+
+func (s *hostsfileSuite) TestUpdateUnchangedDoesNotWrite() {
+	// neither writable nor sudo, and no command: any write would fail
+	client := s.newClient(false)
+
+	s.Require().NoError(client.Update("/src/other", []Entry{{IP: "127.0.0.1", Names: []string{"other.gumroad.dev"}}}))
+	s.Require().Equal(hostsTestContent, s.read())
+}
+
+func (s *hostsfileSuite) TestUpdateReplacesAndRemovesOwnBlockOnly() {
+	s.mockSys.EXPECT().IsSudo().Return(true)
+	client := s.newClient(false)
+
+	s.Require().NoError(client.Update("/src/other", []Entry{{IP: "::1", Names: []string{"other.gumroad.dev"}}}))
+	s.Require().Equal("127.0.0.1\tlocalhost\n::1\tlocalhost\n# BEGIN gum /src/other\n::1 other.gumroad.dev\n# END gum /src/other\n", s.read())
+
+	s.Require().NoError(client.Update("/src/other", []Entry{}))
+	s.Require().Equal("127.0.0.1\tlocalhost\n::1\tlocalhost\n", s.read())
+}
+
+func (s *hostsfileSuite) TestUpdateWithSudo() {
+	s.mockSys.EXPECT().IsSudo().Return(false)
+	copyCmd := fakecmdexec.NewNoOpCommand()
+	client := s.newClient(false, copyCmd)
+
+	err := client.Update("/src/app", []Entry{{IP: "127.0.0.1", Names: []string{"app.gumroad.dev"}}})
+
+	s.Require().NoError(err)
+	s.Require().Equal("sudo", copyCmd.Cmd())
+	s.Require().Len(copyCmd.Args(), 3)
+	s.Require().Equal("cp", copyCmd.Args()[0])
+	s.Require().Equal(s.path, copyCmd.Args()[2])
+	// the temp file is removed after the copy
+	s.Require().NoFileExists(copyCmd.Args()[1])
+	s.Require().Equal(hostsTestContent, s.read())
+}
+
+func (s *hostsfileSuite) TestNewWithPath() {
+	client := NewWithPath(s.path)
+
+	s.Require().NoError(client.Update("/src/app", []Entry{{IP: "127.0.0.1", Names: []string{"app.gumroad.dev"}}}))
+	s.Require().Contains(s.read(), "# BEGIN gum /src/app\n127.0.0.1 app.gumroad.dev\n# END gum /src/app\n")
+
+	s.Require().NoError(client.Update("/src/app", nil))
+	s.Require().Equal(hostsTestContent, s.read())
+}
+
+func (s *hostsfileSuite) TestValidate() {
+	s.Require().NoError(Entry{IP: "::1", Names: []string{"app.gumroad.dev"}}.Validate())
+	s.Require().ErrorContains(Entry{IP: "localhost", Names: []string{"app.gumroad.dev"}}.Validate(), `Invalid IP address "localhost"`)
+	s.Require().ErrorContains(Entry{IP: "127.0.0.1", Names: []string{"app gumroad.dev"}}.Validate(), `Invalid hostname "app gumroad.dev"`)
+	s.Require().ErrorContains(Entry{IP: "127.0.0.1"}.Validate(), "requires at least one name")
+}
+
+func TestHostsfileSuite(t *testing.T) {
+	suite.Run(t, new(hostsfileSuite))
+}
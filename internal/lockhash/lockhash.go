@@ -0,0 +1,65 @@
+// Package lockhash records the hash of the dependency file of a project
+// after a successful install, so that the install only runs again once the
+// file changes.
+package lockhash
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+)
+
+// IsCurrent reports whether record holds the hash of the dependency file at
+// path.
+func IsCurrent(fs filesystem.Client, path, record string) bool {
+	if !fs.Exists(record) {
+		log.Debugf("No dependencies installed by gum")
+		return false
+	}
+
+	installed, err := fs.ReadString(record)
+	if err != nil {
+		log.Debugf("Unable to read %s: %s", record, err)
+		return false
+	}
+
+	current, err := Hash(fs, path)
+	if err != nil {
+		log.Debugf("%s", err)
+		return false
+	}
+
+	return strings.TrimSpace(installed) == current
+}
+
+// Record writes the hash of the dependency file at path to record, creating
+// its directory.
+func Record(fs filesystem.Client, path, record string) error {
+	hash, err := Hash(fs, path)
+	if err != nil {
+		return err
+	}
+
+	if err := fs.MkdirAll(filepath.Dir(record)); err != nil {
+		return err
+	}
+
+	return fs.WriteString(record, hash)
+}
+
+// Hash returns the hex encoded sha256 of the file at path.
+func Hash(fs filesystem.Client, path string) (string, error) {
+	content, err := fs.ReadString(path)
+	if err != nil {
+		return "", errors.Errorf("Unable to read %s: %s", filepath.Base(path), err)
+	}
+
+	sum := sha256.Sum256([]byte(content))
+
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,49 @@
+package lockhash
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type lockhashSuite struct {
+	suite.Suite
+	fs  filesystem.Client
+	dir string
+}
+
+func (s *lockhashSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *lockhashSuite) SetupTest() {
+	s.fs = filesystem.New()
+	s.dir = s.T().TempDir()
+}
+
+func (s *lockhashSuite) TestRecordAndIsCurrent() {
+	lockfile := filepath.Join(s.dir, "uv.lock")
+	record := filepath.Join(s.dir, ".venv", ".gum-lockfile-hash")
+	s.Require().NoError(s.fs.WriteString(lockfile, "version = 1\n"))
+
+	s.Require().False(IsCurrent(s.fs, lockfile, record))
+	s.Require().NoError(Record(s.fs, lockfile, record))
+	s.Require().True(IsCurrent(s.fs, lockfile, record))
+
+	s.Require().NoError(s.fs.WriteString(lockfile, "version = 2\n"))
+	s.Require().False(IsCurrent(s.fs, lockfile, record))
+}
+
+func (s *lockhashSuite) TestRecordMissingFile() {
+	err := Record(s.fs, filepath.Join(s.dir, "package.json"), filepath.Join(s.dir, "record"))
+
+	s.Require().ErrorContains(err, "Unable to read package.json")
+}
+
+func TestLockhashSuite(t *testing.T) {
+	suite.Run(t, new(lockhashSuite))
+}
@@ -34,7 +34,9 @@ func IsInitialized() bool {
 }
 
 func Initialize(level LogLevel) error {
-	writer = zerolog.ConsoleWriter{Out: os.Stdout}
+	// Logs go to stderr: stdout is the output of commands such as gum env and
+	// gum cd, which the shell evaluates or captures.
+	writer = zerolog.ConsoleWriter{Out: &redactingWriter{out: os.Stderr}}
 
 	writer.FormatLevel = func(i interface{}) string {
 		var l string
@@ -1,6 +1,9 @@
 package log
 
 import (
+	"bytes"
+	"io"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -17,6 +20,40 @@ func (s *logSuite) TestErrorLevel() {
 	assert.Error(s.T(), err)
 }
 
+func (s *logSuite) TestWritesToStderr() {
+	stdout, stderr := os.Stdout, os.Stderr
+	stdoutR, stdoutW, err := os.Pipe()
+	s.Require().NoError(err)
+	stderrR, stderrW, err := os.Pipe()
+	s.Require().NoError(err)
+	os.Stdout, os.Stderr = stdoutW, stderrW
+
+	err = Initialize(LogInfo)
+	Infoln("hello")
+	os.Stdout, os.Stderr = stdout, stderr
+	stdoutW.Close()
+	stderrW.Close()
+	s.Require().NoError(err)
+
+	out, _ := io.ReadAll(stdoutR)
+	logs, _ := io.ReadAll(stderrR)
+	s.Require().Empty(string(out))
+	s.Require().Contains(string(logs), "hello")
+	s.Require().NoError(Initialize(LogDisabled))
+}
+
+func (s *logSuite) TestRedact() {
+	Redact("hunter22", "abc")
+	out := &bytes.Buffer{}
+	w := &redactingWriter{out: out}
+
+	n, err := w.Write([]byte("password=hunter22 abc\n"))
+
+	s.Require().NoError(err)
+	s.Require().Equal(22, n)
+	s.Require().Equal("password=[REDACTED] abc\n", out.String())
+}
+
 func TestLogSuite(t *testing.T) {
 	suite.Run(t, new(logSuite))
 }
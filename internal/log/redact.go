@@ -0,0 +1,54 @@
+package log
+
+import (
+	"io"
+	"strings"
+	"sync"
+)
+
+const redacted = "[REDACTED]"
+
+var (
+	secretsMu sync.RWMutex
+	secrets   = []string{}
+)
+
+// Redact registers values, such as resolved secrets, that are replaced in
+// every log line written afterwards.
+func Redact(values ...string) {
+	secretsMu.Lock()
+	defer secretsMu.Unlock()
+
+	for _, value := range values {
+		// short values would redact unrelated words
+		if len(value) >= 4 {
+			secrets = append(secrets, value)
+		}
+	}
+}
+
+// RedactString replaces the registered secrets of s.
+func RedactString(s string) string {
+	secretsMu.RLock()
+	defer secretsMu.RUnlock()
+
+	for _, secret := range secrets {
+		s = strings.ReplaceAll(s, secret, redacted)
+	}
+
+	return s
+}
+
+// redactingWriter redacts the log lines before writing them. The console
+// writer writes one line per call, so secrets are never split.
+type redactingWriter struct {
+	out io.Writer
+}
+
+func (w *redactingWriter) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(w.out, RedactString(string(p))); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
@@ -0,0 +1,73 @@
+package prompt
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Client asks the user for values on the terminal.
+type Client interface {
+	IsInteractive() bool
+	Ask(question, defaultValue string) (string, error)
+}
+
+type client struct {
+	in          *bufio.Reader
+	out         io.Writer
+	interactive bool
+}
+
+func New() Client {
+	return newClientWithComponents(os.Stdin, os.Stderr, isTerminal(os.Stdin))
+}
+
+func newClientWithComponents(in io.Reader, out io.Writer, interactive bool) *client {
+	return &client{
+		in:          bufio.NewReader(in),
+		out:         out,
+		interactive: interactive,
+	}
+}
+
+// IsInteractive reports whether stdin is a terminal, so that questions can
+// be answered.
+func (c *client) IsInteractive() bool {
+	return c.interactive
+}
+
+// Ask prints the question and reads one line. An empty answer returns the
+// default value.
+func (c *client) Ask(question, defaultValue string) (string, error) {
+	if defaultValue != "" {
+		question = fmt.Sprintf("%s [%s]", question, defaultValue)
+	}
+
+	if _, err := fmt.Fprintf(c.out, "%s: ", question); err != nil {
+		return "", err
+	}
+
+	answer, err := c.in.ReadString('\n')
+	if err != nil && (err != io.EOF || answer == "") {
+		return "", errors.Errorf("Failed to read the answer: %s", err)
+	}
+
+	if answer = strings.TrimSpace(answer); answer != "" {
+		return answer, nil
+	}
+
+	return defaultValue, nil
+}
+
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,35 @@
+package prompt
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type promptSuite struct {
+	suite.Suite
+}
+
+func (s *promptSuite) TestAsk() {
+	out := &bytes.Buffer{}
+	client := newClientWithComponents(strings.NewReader("sk_test_123\n\n"), out, true)
+
+	answer, err := client.Ask("STRIPE_KEY", "")
+	s.Require().NoError(err)
+	s.Require().Equal("sk_test_123", answer)
+
+	answer, err = client.Ask("PORT", "3000")
+	s.Require().NoError(err)
+	s.Require().Equal("3000", answer)
+
+	s.Require().Equal("STRIPE_KEY: PORT [3000]: ", out.String())
+
+	_, err = client.Ask("REDIS_URL", "")
+	s.Require().ErrorContains(err, "Failed to read the answer: EOF")
+}
+
+func TestPromptSuite(t *testing.T) {
+	suite.Run(t, new(promptSuite))
+}
@@ -0,0 +1,457 @@
+package releases
+
+import (
+	"encoding/json"
+	"io"
+	"path"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/download"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/github"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo"
+	"github.com/renegumroad/gum-cli/internal/templates"
+)
+
+var repoRe = regexp.MustCompile(`^[A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+$`)
+
+// Spec is a binary published in the releases of a GitHub repository.
+type Spec struct {
+	// Repo is owner/repo.
+	Repo string
+	// Version is the release tag, with or without its v prefix. The latest
+	// release is used when it is empty.
+	Version string
+	// Name is the binary, installed as /opt/gumroad/bin/<name>. It defaults
+	// to the repository name.
+	Name string
+	// Asset is a glob, templated with the version, OS and arch, selecting
+	// the asset when its name doesn't follow the usual patterns.
+	Asset string
+	// Path is the binary inside archives, templated like Asset. It defaults
+	// to the repository name, found in any directory.
+	Path string
+}
+
+// ParseSpec reads owner/repo[@version].
+func ParseSpec(spec string) (*Spec, error) {
+	repo, version, _ := strings.Cut(strings.TrimSpace(spec), "@")
+	s := &Spec{Repo: repo, Version: version}
+
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *Spec) Validate() error {
+	if !repoRe.MatchString(s.Repo) {
+		return errors.Errorf("Invalid repository %q: expected owner/repo", s.Repo)
+	}
+
+	if strings.ContainsRune(s.BinaryName(), '/') {
+		return errors.Errorf("Invalid binary name %q: it must be a file name", s.Name)
+	}
+
+	return nil
+}
+
+// BinaryName returns Name, or the repository name.
+func (s *Spec) BinaryName() string {
+	if s.Name != "" {
+		return s.Name
+	}
+
+	return s.repoName()
+}
+
+func (s *Spec) repoName() string {
+	_, name, _ := strings.Cut(s.Repo, "/")
+	return name
+}
+
+// member returns the binary to take out of an archive of the release: Path,
+// or the repository name.
+func (s *Spec) member(release *github.Release, platform, arch string) (string, error) {
+	if s.Path == "" {
+		return s.repoName(), nil
+	}
+
+	return render("path", s.Path, release, platform, arch)
+}
+
+// Entry is a binary installed from a release, in the manifest.
+type Entry struct {
+	Repo    string `json:"repo"`
+	Version string `json:"version"`
+	Asset   string `json:"asset"`
+	Sha256  string `json:"sha256"`
+}
+
+// Client installs binaries from GitHub releases in /opt/gumroad/bin, and
+// records their versions in /opt/gumroad/manifest.json.
+type Client interface {
+	// IsInstalled reports whether the binary of spec is installed from its
+	// repository, at its version when it has one.
+	IsInstalled(spec *Spec) bool
+	// Install installs the binary of the release of spec, unless the
+	// manifest already has it.
+	Install(spec *Spec) (*Entry, error)
+}
+
+type client struct {
+	gumroadDir string
+	arch       string
+	fs         filesystem.Client
+	sys        systeminfo.Client
+	github     github.Client
+	download   download.Client
+}
+
+func New() (Client, error) {
+	githubClient, err := github.New()
+	if err != nil {
+		return nil, err
+	}
+
+	downloadClient, err := download.New()
+	if err != nil {
+		return nil, err
+	}
+
+	fs := filesystem.New()
+	return newClientWithComponents(filepath.Join(fs.RootDir(), "opt", "gumroad"), runtime.GOARCH, fs, systeminfo.New(), githubClient, downloadClient), nil
+}
+
+func newClientWithComponents(
+	gumroadDir string,
+	arch string,
+	fs filesystem.Client,
+	sys systeminfo.Client,
+	githubClient github.Client,
+	downloadClient download.Client,
+) *client {
+	return &client{
+		gumroadDir: gumroadDir,
+		arch:       arch,
+		fs:         fs,
+		sys:        sys,
+		github:     githubClient,
+		download:   downloadClient,
+	}
+}
+
+func (c *client) IsInstalled(spec *Spec) bool {
+	manifest, err := c.readManifest()
+	if err != nil {
+		log.Debugf("%s", err)
+		return false
+	}
+
+	entry, found := manifest[spec.BinaryName()]
+	if !found || entry.Repo != spec.Repo || !c.fs.IsExecutable(c.binaryPath(spec)) {
+		return false
+	}
+
+	return spec.Version == "" || sameVersion(entry.Version, spec.Version)
+}
+
+func (c *client) Install(spec *Spec) (*Entry, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	binDir := filepath.Join(c.gumroadDir, "bin")
+	if !c.fs.IsDir(binDir) {
+		return nil, errors.Errorf("%s does not exist. Run gum init first", binDir)
+	}
+
+	release, err := c.github.Release(spec.Repo, spec.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := c.readManifest()
+	if err != nil {
+		return nil, err
+	}
+
+	name := spec.BinaryName()
+	dest := c.binaryPath(spec)
+	if entry, found := manifest[name]; found && entry.Repo == spec.Repo && entry.Version == release.TagName && c.fs.IsExecutable(dest) {
+		log.Infof("%s %s is already installed", name, release.TagName)
+		return entry, nil
+	}
+
+	asset, err := SelectAsset(release, spec.Asset, c.sys.CurrentPlatform(), c.arch)
+	if err != nil {
+		return nil, errors.Errorf("%s %s: %s", spec.Repo, release.TagName, err)
+	}
+
+	checksum, err := c.checksum(release, asset)
+	if err != nil {
+		return nil, err
+	}
+
+	if checksum == "" {
+		log.Warnf("%s %s publishes no checksum for %s, it can't be verified", spec.Repo, release.TagName, asset.Name)
+	}
+
+	// through the API, for the token of private repositories
+	file, err := c.download.FetchWith(asset.Name, checksum, func() (io.ReadCloser, error) {
+		return c.github.OpenAsset(asset)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Installing %s %s in %s", name, release.TagName, dest)
+	member, err := spec.member(release, c.sys.CurrentPlatform(), c.arch)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.download.Install(file, Format(asset.Name), member, dest); err != nil {
+		return nil, err
+	}
+
+	entry := &Entry{
+		Repo:    spec.Repo,
+		Version: release.TagName,
+		Asset:   asset.Name,
+		Sha256:  filepath.Base(file),
+	}
+	manifest[name] = entry
+
+	return entry, c.writeManifest(manifest)
+}
+
+func (c *client) binaryPath(spec *Spec) string {
+	return filepath.Join(c.gumroadDir, "bin", spec.BinaryName())
+}
+
+func (c *client) manifestPath() string {
+	return filepath.Join(c.gumroadDir, "manifest.json")
+}
+
+func (c *client) readManifest() (map[string]*Entry, error) {
+	manifest := map[string]*Entry{}
+
+	path := c.manifestPath()
+	if !c.fs.Exists(path) {
+		return manifest, nil
+	}
+
+	content, err := c.fs.ReadString(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(content), &manifest); err != nil {
+		return nil, errors.Errorf("Unable to read %s: %s", path, err)
+	}
+
+	return manifest, nil
+}
+
+func (c *client) writeManifest(manifest map[string]*Entry) error {
+	content, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return errors.Errorf("Unable to encode %s: %s", c.manifestPath(), err)
+	}
+
+	return c.fs.WriteStringAtomic(c.manifestPath(), string(content)+"\n")
+}
+
+// checksum returns the sha256 of asset from its digest, a <asset>.sha256
+// file or a checksums file of the release. It is empty when the release has
+// none of them.
+func (c *client) checksum(release *github.Release, asset *github.Asset) (string, error) {
+	if digest, found := strings.CutPrefix(asset.Digest, "sha256:"); found {
+		return digest, nil
+	}
+
+	checksumsFiles := []string{}
+	for _, candidate := range release.Assets {
+		sidecar := candidate.Name == asset.Name+".sha256" || candidate.Name == asset.Name+".sha256sum"
+		if !sidecar && !isChecksumsFile(candidate.Name) {
+			continue
+		}
+
+		content, err := c.github.ReadAsset(&candidate)
+		if err != nil {
+			return "", err
+		}
+
+		if checksum := findChecksum(string(content), asset.Name, sidecar); checksum != "" {
+			log.Debugf("Verifying %s with %s", asset.Name, candidate.Name)
+			return checksum, nil
+		}
+		checksumsFiles = append(checksumsFiles, candidate.Name)
+	}
+
+	if len(checksumsFiles) > 0 {
+		return "", errors.Errorf("%s is not listed in %s", asset.Name, strings.Join(checksumsFiles, ", "))
+	}
+
+	return "", nil
+}
+
+var sha256Re = regexp.MustCompile(`^[0-9a-fA-F]{64}$`)
+
+// findChecksum reads sha256sum output. A sidecar file may only hold the
+// checksum.
+func findChecksum(content, name string, sidecar bool) string {
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || !sha256Re.MatchString(fields[0]) {
+			continue
+		}
+
+		if len(fields) == 1 && sidecar {
+			return strings.ToLower(fields[0])
+		}
+
+		if len(fields) >= 2 && strings.TrimPrefix(strings.TrimPrefix(fields[1], "*"), "./") == name {
+			return strings.ToLower(fields[0])
+		}
+	}
+
+	return ""
+}
+
+func isChecksumsFile(name string) bool {
+	lower := strings.ToLower(name)
+	if hasAnySuffix(lower, ".sig", ".asc", ".pem") {
+		return false
+	}
+
+	return strings.Contains(lower, "checksums") || strings.Contains(lower, "sha256sums")
+}
+
+var (
+	osAliases = map[string][]string{
+		"darwin": {"darwin", "macos", "mac", "osx", "apple"},
+		"linux":  {"linux"},
+	}
+	archAliases = map[string][]string{
+		"amd64": {"amd64", "x86_64", "x64"},
+		"arm64": {"arm64", "aarch64"},
+	}
+	// unsupportedSuffixes are assets that are not binaries, or archives gum
+	// can't extract.
+	unsupportedSuffixes = []string{
+		".sha256", ".sha256sum", ".sha512", ".md5", ".sig", ".asc", ".pem", ".crt", ".sbom", ".json", ".jsonl",
+		".txt", ".yml", ".yaml", ".deb", ".rpm", ".apk", ".msi", ".exe", ".dmg", ".pkg", ".gz", ".xz", ".bz2",
+		".zst", ".7z",
+	}
+)
+
+// Format returns the archive format of an asset, from its name.
+func Format(name string) string {
+	lower := strings.ToLower(name)
+	switch {
+	case hasAnySuffix(lower, ".tar.gz", ".tgz"):
+		return download.FormatTarGz
+	case strings.HasSuffix(lower, ".zip"):
+		return download.FormatZip
+	default:
+		return ""
+	}
+}
+
+// SelectAsset returns the asset of the release for a platform and arch:
+// the one matching pattern when it is set, otherwise the one whose name has
+// the OS and the arch in it, preferring tar.gz, then zip, then raw binaries.
+func SelectAsset(release *github.Release, pattern, platform, arch string) (*github.Asset, error) {
+	if pattern != "" {
+		return matchAsset(release, pattern, platform, arch)
+	}
+
+	candidates := []*github.Asset{}
+	for i, asset := range release.Assets {
+		lower := strings.ToLower(asset.Name)
+		if Format(lower) == "" && hasAnySuffix(lower, unsupportedSuffixes...) {
+			continue
+		}
+
+		hasArch := hasAnyWord(lower, archAliases[arch]) || (platform == "darwin" && hasAnyWord(lower, []string{"universal", "all"}))
+		if hasAnyWord(lower, osAliases[platform]) && hasArch {
+			candidates = append(candidates, &release.Assets[i])
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, errors.Errorf("no asset for %s/%s. Set asset to a pattern of the asset name", platform, arch)
+	}
+
+	rank := func(asset *github.Asset) int {
+		return slices.Index([]string{download.FormatTarGz, download.FormatZip, ""}, Format(asset.Name))
+	}
+	best := slices.MinFunc(candidates, func(a, b *github.Asset) int { return rank(a) - rank(b) })
+	candidates = slices.DeleteFunc(candidates, func(asset *github.Asset) bool { return rank(asset) != rank(best) })
+
+	if len(candidates) > 1 {
+		names := []string{}
+		for _, asset := range candidates {
+			names = append(names, asset.Name)
+		}
+		return nil, errors.Errorf("several assets for %s/%s: %s. Set asset to a pattern of the asset name", platform, arch, strings.Join(names, ", "))
+	}
+
+	return candidates[0], nil
+}
+
+func matchAsset(release *github.Release, pattern, platform, arch string) (*github.Asset, error) {
+	rendered, err := render("asset", pattern, release, platform, arch)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, asset := range release.Assets {
+		matched, err := path.Match(rendered, asset.Name)
+		if err != nil {
+			return nil, errors.Errorf("invalid asset pattern %s: %s", rendered, err)
+		}
+
+		if matched {
+			return &release.Assets[i], nil
+		}
+	}
+
+	return nil, errors.Errorf("no asset matches %s", rendered)
+}
+
+// render renders a template of the asset or path with the version of the
+// release, the OS and the arch.
+func render(name, text string, release *github.Release, platform, arch string) (string, error) {
+	return templates.Render(name, text, map[string]string{
+		"Version": strings.TrimPrefix(release.TagName, "v"),
+		"OS":      platform,
+		"Arch":    arch,
+	})
+}
+
+func sameVersion(a, b string) bool {
+	return strings.TrimPrefix(a, "v") == strings.TrimPrefix(b, "v")
+}
+
+func hasAnySuffix(s string, suffixes ...string) bool {
+	return slices.ContainsFunc(suffixes, func(suffix string) bool {
+		return strings.HasSuffix(s, suffix)
+	})
+}
+
+// hasAnyWord reports whether name has one of words between separators.
+func hasAnyWord(name string, words []string) bool {
+	return slices.ContainsFunc(words, func(word string) bool {
+		return regexp.MustCompile(`(^|[^a-z0-9])` + regexp.QuoteMeta(word) + `([^a-z0-9]|$)`).MatchString(name)
+	})
+}
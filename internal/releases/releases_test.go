@@ -0,0 +1,242 @@
+package releases
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/download"
+	"github.com/renegumroad/gum-cli/internal/download/mockdownload"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/github"
+	"github.com/renegumroad/gum-cli/internal/github/mockgithub"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/systeminfo/mocksysteminfo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const toolSha256 = "0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9"
+
+type releasesSuite struct {
+	suite.Suite
+	gumroadDir   string
+	mockSys      *mocksysteminfo.MockClient
+	mockGithub   *mockgithub.MockClient
+	mockDownload *mockdownload.MockClient
+}
+
+func (s *releasesSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *releasesSuite) SetupTest() {
+	s.gumroadDir = s.T().TempDir()
+	s.Require().NoError(os.MkdirAll(filepath.Join(s.gumroadDir, "bin"), 0755))
+	s.mockSys = mocksysteminfo.NewMockClient(s.T())
+	s.mockGithub = mockgithub.NewMockClient(s.T())
+	s.mockDownload = mockdownload.NewMockClient(s.T())
+	s.mockSys.EXPECT().CurrentPlatform().Return("linux").Maybe()
+}
+
+func (s *releasesSuite) newClient() *client {
+	return newClientWithComponents(s.gumroadDir, "amd64", filesystem.New(), s.mockSys, s.mockGithub, s.mockDownload)
+}
+
+func (s *releasesSuite) release() *github.Release {
+	return &github.Release{
+		TagName: "v1.2.0",
+		Assets: []github.Asset{
+			{Name: "checksums.txt", URL: "https://example.com/checksums.txt"},
+			{Name: "tool_1.2.0_darwin_arm64.tar.gz", URL: "https://example.com/tool_darwin_arm64.tar.gz"},
+			{Name: "tool_1.2.0_linux_amd64.tar.gz", URL: "https://example.com/tool_linux_amd64.tar.gz"},
+			{Name: "tool_1.2.0_linux_arm64.tar.gz", URL: "https://example.com/tool_linux_arm64.tar.gz"},
+			{Name: "tool_1.2.0_linux_arm64.deb", URL: "https://example.com/tool_linux_arm64.deb"},
+		},
+	}
+}
+
+// fakeFetch reads the asset like the download client would.
+func (s *releasesSuite) fakeFetch(_, checksum string, open func() (io.ReadCloser, error)) (string, error) {
+	body, err := open()
+	if err != nil {
+		return "", err
+	}
+	defer body.Close()
+
+	_, err = io.ReadAll(body)
+	return "/cache/" + checksum, err
+}
+
+// fakeInstall writes the binary like the download client would.
+func (s *releasesSuite) fakeInstall(_, _, _, dest string) error {
+	return os.WriteFile(dest, []byte("#!/bin/sh\n"), 0755)
+}
+
+func (s *releasesSuite) TestParseSpec() {
+	spec, err := ParseSpec("gumroad/tool@1.2.0")
+	s.Require().NoError(err)
+	s.Require().Equal(&Spec{Repo: "gumroad/tool", Version: "1.2.0"}, spec)
+	s.Require().Equal("tool", spec.BinaryName())
+
+	_, err = ParseSpec("tool")
+	s.Require().EqualError(err, `Invalid repository "tool": expected owner/repo`)
+}
+
+func (s *releasesSuite) TestSelectAsset() {
+	release := s.release()
+
+	for _, test := range []struct {
+		platform string
+		arch     string
+		expected string
+	}{
+		{"linux", "amd64", "tool_1.2.0_linux_amd64.tar.gz"},
+		{"linux", "arm64", "tool_1.2.0_linux_arm64.tar.gz"},
+		{"darwin", "arm64", "tool_1.2.0_darwin_arm64.tar.gz"},
+	} {
+		asset, err := SelectAsset(release, "", test.platform, test.arch)
+		s.Require().NoError(err)
+		s.Require().Equal(test.expected, asset.Name)
+	}
+
+	_, err := SelectAsset(release, "", "darwin", "amd64")
+	s.Require().EqualError(err, "no asset for darwin/amd64. Set asset to a pattern of the asset name")
+
+	release.Assets = append(release.Assets, github.Asset{Name: "tool_1.2.0_linux_x86_64_musl.tar.gz"})
+	_, err = SelectAsset(release, "", "linux", "amd64")
+	s.Require().EqualError(err, "several assets for linux/amd64: tool_1.2.0_linux_amd64.tar.gz, tool_1.2.0_linux_x86_64_musl.tar.gz. Set asset to a pattern of the asset name")
+
+	asset, err := SelectAsset(release, "tool_{{ .Version }}_{{ .OS }}_x86_64_*", "linux", "amd64")
+	s.Require().NoError(err)
+	s.Require().Equal("tool_1.2.0_linux_x86_64_musl.tar.gz", asset.Name)
+
+	raw := &github.Release{Assets: []github.Asset{{Name: "tool-macos-universal"}, {Name: "tool-macos-universal.sha256"}}}
+	asset, err = SelectAsset(raw, "", "darwin", "arm64")
+	s.Require().NoError(err)
+	s.Require().Equal("tool-macos-universal", asset.Name)
+}
+
+func (s *releasesSuite) TestInstallVerifiesWithChecksumsFile() {
+	release := s.release()
+	s.mockGithub.EXPECT().Release("gumroad/tool", "1.2.0").Return(release, nil)
+	s.mockGithub.EXPECT().ReadAsset(&release.Assets[0]).Return([]byte(strings.Repeat("f", 64)+"  tool_1.2.0_darwin_arm64.tar.gz\n"+toolSha256+"  tool_1.2.0_linux_amd64.tar.gz\n"), nil)
+	s.mockGithub.EXPECT().OpenAsset(&release.Assets[2]).Return(io.NopCloser(strings.NewReader("archive")), nil)
+	s.mockDownload.EXPECT().FetchWith("tool_1.2.0_linux_amd64.tar.gz", toolSha256, mock.Anything).RunAndReturn(s.fakeFetch)
+	dest := filepath.Join(s.gumroadDir, "bin", "tool")
+	s.mockDownload.EXPECT().Install("/cache/"+toolSha256, "tar.gz", "tool", dest).RunAndReturn(s.fakeInstall)
+	client := s.newClient()
+	spec := &Spec{Repo: "gumroad/tool", Version: "1.2.0"}
+
+	s.Require().False(client.IsInstalled(spec))
+	entry, err := client.Install(spec)
+
+	s.Require().NoError(err)
+	s.Require().Equal(&Entry{Repo: "gumroad/tool", Version: "v1.2.0", Asset: "tool_1.2.0_linux_amd64.tar.gz", Sha256: toolSha256}, entry)
+	s.Require().True(client.IsInstalled(spec))
+	s.Require().True(client.IsInstalled(&Spec{Repo: "gumroad/tool"}))
+	s.Require().False(client.IsInstalled(&Spec{Repo: "gumroad/tool", Version: "1.3.0"}))
+
+	manifest, err := os.ReadFile(filepath.Join(s.gumroadDir, "manifest.json"))
+	s.Require().NoError(err)
+	s.Require().Contains(string(manifest), `"version": "v1.2.0"`)
+
+	entry, err = client.Install(spec)
+	s.Require().NoError(err)
+	s.Require().Equal("v1.2.0", entry.Version)
+}
+
+func (s *releasesSuite) TestInstallChecksumErrors() {
+	release := s.release()
+	s.mockGithub.EXPECT().Release("gumroad/tool", "").Return(release, nil)
+	s.mockGithub.EXPECT().ReadAsset(&release.Assets[0]).Return([]byte("not a checksum\n"), nil)
+
+	_, err := s.newClient().Install(&Spec{Repo: "gumroad/tool"})
+
+	s.Require().EqualError(err, "tool_1.2.0_linux_amd64.tar.gz is not listed in checksums.txt")
+}
+
+func (s *releasesSuite) TestInstallUsesDigest() {
+	release := &github.Release{
+		TagName: "v2.0.0",
+		Assets:  []github.Asset{{Name: "tool-linux-amd64", URL: "https://example.com/tool", Digest: "sha256:" + toolSha256}},
+	}
+	s.mockGithub.EXPECT().Release("gumroad/tool", "").Return(release, nil)
+	s.mockGithub.EXPECT().OpenAsset(&release.Assets[0]).Return(io.NopCloser(strings.NewReader("binary")), nil)
+	s.mockDownload.EXPECT().FetchWith("tool-linux-amd64", toolSha256, mock.Anything).RunAndReturn(s.fakeFetch)
+	s.mockDownload.EXPECT().Install("/cache/"+toolSha256, "", "tool", filepath.Join(s.gumroadDir, "bin", "tool")).RunAndReturn(s.fakeInstall)
+
+	_, err := s.newClient().Install(&Spec{Repo: "gumroad/tool"})
+
+	s.Require().NoError(err)
+}
+
+func (s *releasesSuite) TestInstallWithName() {
+	release := s.release()
+	s.mockGithub.EXPECT().Release("gumroad/tool", "1.2.0").Return(release, nil)
+	s.mockGithub.EXPECT().ReadAsset(&release.Assets[0]).Return([]byte(toolSha256+"  tool_1.2.0_linux_amd64.tar.gz\n"), nil)
+	s.mockGithub.EXPECT().OpenAsset(&release.Assets[2]).Return(io.NopCloser(strings.NewReader("archive")), nil)
+	s.mockDownload.EXPECT().FetchWith("tool_1.2.0_linux_amd64.tar.gz", toolSha256, mock.Anything).RunAndReturn(s.fakeFetch)
+	dest := filepath.Join(s.gumroadDir, "bin", "gumroad-tool")
+	s.mockDownload.EXPECT().Install("/cache/"+toolSha256, "tar.gz", "tool", dest).RunAndReturn(s.fakeInstall)
+	client := s.newClient()
+	spec := &Spec{Repo: "gumroad/tool", Version: "1.2.0", Name: "gumroad-tool"}
+
+	_, err := client.Install(spec)
+
+	s.Require().NoError(err)
+	s.Require().FileExists(dest)
+	s.Require().True(client.IsInstalled(spec))
+	s.Require().False(client.IsInstalled(&Spec{Repo: "gumroad/tool"}))
+}
+
+func (s *releasesSuite) TestInstallPathFromArchive() {
+	archive := &bytes.Buffer{}
+	gz := gzip.NewWriter(archive)
+	tw := tar.NewWriter(gz)
+	binary := "#!/bin/sh\necho gh\n"
+	s.Require().NoError(tw.WriteHeader(&tar.Header{Name: "gh_2.40.0_linux_amd64/bin/gh", Mode: 0755, Size: int64(len(binary))}))
+	_, err := tw.Write([]byte(binary))
+	s.Require().NoError(err)
+	s.Require().NoError(tw.Close())
+	s.Require().NoError(gz.Close())
+	sum := sha256.Sum256(archive.Bytes())
+
+	release := &github.Release{
+		TagName: "v2.40.0",
+		Assets:  []github.Asset{{Name: "gh_2.40.0_linux_amd64.tar.gz", Digest: "sha256:" + hex.EncodeToString(sum[:])}},
+	}
+	s.mockGithub.EXPECT().Release("cli/cli", "").Return(release, nil)
+	s.mockGithub.EXPECT().OpenAsset(&release.Assets[0]).Return(io.NopCloser(bytes.NewReader(archive.Bytes())), nil)
+	s.T().Setenv("HOME", s.T().TempDir())
+	downloadClient, err := download.New()
+	s.Require().NoError(err)
+	client := newClientWithComponents(s.gumroadDir, "amd64", filesystem.New(), s.mockSys, s.mockGithub, downloadClient)
+
+	_, err = client.Install(&Spec{Repo: "cli/cli", Name: "gh", Path: "gh_{{ .Version }}_{{ .OS }}_{{ .Arch }}/bin/gh"})
+
+	s.Require().NoError(err)
+	content, err := os.ReadFile(filepath.Join(s.gumroadDir, "bin", "gh"))
+	s.Require().NoError(err)
+	s.Require().Equal(binary, string(content))
+}
+
+func (s *releasesSuite) TestInstallRequiresInit() {
+	s.Require().NoError(os.RemoveAll(filepath.Join(s.gumroadDir, "bin")))
+
+	_, err := s.newClient().Install(&Spec{Repo: "gumroad/tool"})
+
+	s.Require().EqualError(err, filepath.Join(s.gumroadDir, "bin")+" does not exist. Run gum init first")
+}
+
+func TestReleasesSuite(t *testing.T) {
+	suite.Run(t, new(releasesSuite))
+}
@@ -0,0 +1,216 @@
+package repos
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/git"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/userconfig"
+)
+
+const (
+	DefaultHost    = "github.com"
+	defaultSrcRoot = "~/src"
+
+	ProtocolSSH   = "ssh"
+	ProtocolHTTPS = "https"
+)
+
+// Repo is a repository named <host>/<org>/<name>, cloned in the same path
+// under the source root.
+type Repo struct {
+	Host string
+	Org  string
+	Name string
+}
+
+// Parse reads org/repo, host/org/repo, https://host/org/repo and
+// git@host:org/repo specs. The host defaults to github.com.
+func Parse(spec string) (*Repo, error) {
+	path := strings.TrimSpace(spec)
+	if rest, found := strings.CutPrefix(path, "git@"); found {
+		path = strings.Replace(rest, ":", "/", 1)
+	} else if _, rest, found := strings.Cut(path, "://"); found {
+		path = rest
+	}
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+
+	parts := strings.Split(path, "/")
+	if len(parts) == 2 {
+		parts = append([]string{DefaultHost}, parts...)
+	}
+
+	if len(parts) != 3 || slices.Contains(parts, "") || slices.Contains(parts, "..") || slices.Contains(parts, ".") {
+		return nil, errors.Errorf("Invalid repository %q: expected org/repo, host/org/repo or a clone URL", spec)
+	}
+
+	return &Repo{Host: parts[0], Org: parts[1], Name: parts[2]}, nil
+}
+
+func (r *Repo) String() string {
+	return fmt.Sprintf("%s/%s/%s", r.Host, r.Org, r.Name)
+}
+
+// URL returns the clone URL of the repository for a protocol.
+func (r *Repo) URL(protocol string) string {
+	if protocol == ProtocolHTTPS {
+		return fmt.Sprintf("https://%s/%s/%s.git", r.Host, r.Org, r.Name)
+	}
+
+	return fmt.Sprintf("git@%s:%s/%s.git", r.Host, r.Org, r.Name)
+}
+
+// Client clones repositories in the source root of the user config.
+type Client interface {
+	Root() string
+	Path(repo *Repo) string
+	IsCloned(repo *Repo) bool
+	// Clone clones the repository from url, or from its URL for the clone
+	// protocol when url is empty, and returns its path.
+	Clone(repo *Repo, url string) (string, error)
+	// Find returns the paths of the cloned repositories matching name,
+	// org/name or host/org/name.
+	Find(name string) ([]string, error)
+}
+
+type client struct {
+	root     string
+	protocol string
+	fs       filesystem.Client
+	git      git.Client
+}
+
+func New() (Client, error) {
+	config, err := userconfig.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	fs := filesystem.New()
+	root, err := resolveRoot(fs, config.SrcRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	protocol := config.CloneProtocol
+	if protocol == "" {
+		protocol = ProtocolSSH
+	}
+
+	if protocol != ProtocolSSH && protocol != ProtocolHTTPS {
+		return nil, errors.Errorf("Unsupported clone_protocol %s in ~/.gum/config.yml. Supported: %s, %s", protocol, ProtocolSSH, ProtocolHTTPS)
+	}
+
+	return newClientWithComponents(root, protocol, fs, git.New()), nil
+}
+
+func newClientWithComponents(root, protocol string, fs filesystem.Client, gitClient git.Client) *client {
+	return &client{
+		root:     root,
+		protocol: protocol,
+		fs:       fs,
+		git:      gitClient,
+	}
+}
+
+func resolveRoot(fs filesystem.Client, root string) (string, error) {
+	if root == "" {
+		root = defaultSrcRoot
+	}
+
+	if rest, found := strings.CutPrefix(root, "~"); found {
+		homeDir, err := fs.HomeDir()
+		if err != nil {
+			return "", err
+		}
+		root = filepath.Join(homeDir, rest)
+	}
+
+	if !filepath.IsAbs(root) {
+		return "", errors.Errorf("src_root %s must be absolute or start with ~", root)
+	}
+
+	return filepath.Clean(root), nil
+}
+
+func (c *client) Root() string {
+	return c.root
+}
+
+func (c *client) Path(repo *Repo) string {
+	return filepath.Join(c.root, repo.Host, repo.Org, repo.Name)
+}
+
+func (c *client) IsCloned(repo *Repo) bool {
+	return c.fs.Exists(filepath.Join(c.Path(repo), ".git"))
+}
+
+func (c *client) Clone(repo *Repo, url string) (string, error) {
+	path := c.Path(repo)
+	if c.IsCloned(repo) {
+		log.Debugf("%s is already cloned in %s", repo, path)
+		return path, nil
+	}
+
+	if c.fs.Exists(path) {
+		return "", errors.Errorf("Cannot clone %s: %s exists and is not a git repository", repo, path)
+	}
+
+	if url == "" {
+		url = repo.URL(c.protocol)
+	}
+
+	if err := c.fs.MkdirAll(filepath.Dir(path)); err != nil {
+		return "", err
+	}
+
+	log.Infof("Cloning %s into %s", url, path)
+	if err := c.git.Clone(url, path); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+func (c *client) Find(name string) ([]string, error) {
+	parts := strings.Split(strings.Trim(name, "/"), "/")
+	if len(parts) > 3 || slices.Contains(parts, "") {
+		return nil, errors.Errorf("Invalid repository %q: expected repo, org/repo or host/org/repo", name)
+	}
+
+	candidates := []string{}
+	for _, host := range c.listDirs(c.root) {
+		for _, org := range c.listDirs(filepath.Join(c.root, host)) {
+			for _, repo := range c.listDirs(filepath.Join(c.root, host, org)) {
+				candidate := []string{host, org, repo}
+				if slices.Equal(candidate[3-len(parts):], parts) {
+					candidates = append(candidates, filepath.Join(c.root, host, org, repo))
+				}
+			}
+		}
+	}
+
+	return candidates, nil
+}
+
+func (c *client) listDirs(dir string) []string {
+	names, err := c.fs.ListDir(dir)
+	if err != nil {
+		log.Debugf("%s", err)
+		return []string{}
+	}
+
+	dirs := []string{}
+	for _, name := range names {
+		if c.fs.IsDir(filepath.Join(dir, name)) {
+			dirs = append(dirs, name)
+		}
+	}
+
+	return dirs
+}
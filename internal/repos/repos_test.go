@@ -0,0 +1,129 @@
+package repos
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/cli/git"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type reposSuite struct {
+	suite.Suite
+	root string
+}
+
+func (s *reposSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *reposSuite) SetupTest() {
+	s.root = s.T().TempDir()
+}
+
+func (s *reposSuite) newClient() *client {
+	return newClientWithComponents(s.root, ProtocolSSH, filesystem.New(), git.New())
+}
+
+// bareRepo creates a local bare repository with one commit.
+func (s *reposSuite) bareRepo() string {
+	dir := s.T().TempDir()
+	work := filepath.Join(dir, "work")
+	bare := filepath.Join(dir, "repo.git")
+
+	for _, args := range [][]string{
+		{"init", "--quiet", work},
+		{"-C", work, "-c", "user.name=gum", "-c", "user.email=gum@example.com", "commit", "--quiet", "--allow-empty", "-m", "init"},
+		{"clone", "--quiet", "--bare", work, bare},
+	} {
+		out, err := exec.Command("git", args...).CombinedOutput()
+		s.Require().NoError(err, string(out))
+	}
+
+	return bare
+}
+
+func (s *reposSuite) TestParse() {
+	for spec, expected := range map[string]string{
+		"gumroad/web":                        "github.com/gumroad/web",
+		"gitlab.com/gumroad/web":             "gitlab.com/gumroad/web",
+		"https://github.com/gumroad/web.git": "github.com/gumroad/web",
+		"git@github.com:gumroad/web.git":     "github.com/gumroad/web",
+	} {
+		repo, err := Parse(spec)
+		s.Require().NoError(err, spec)
+		s.Require().Equal(expected, repo.String())
+	}
+
+	for _, spec := range []string{"web", "gumroad/", "a/b/c/d", "gumroad/.."} {
+		_, err := Parse(spec)
+		s.Require().Error(err, spec)
+	}
+}
+
+func (s *reposSuite) TestURL() {
+	repo := &Repo{Host: "github.com", Org: "gumroad", Name: "web"}
+
+	s.Require().Equal("git@github.com:gumroad/web.git", repo.URL(ProtocolSSH))
+	s.Require().Equal("https://github.com/gumroad/web.git", repo.URL(ProtocolHTTPS))
+}
+
+func (s *reposSuite) TestResolveRoot() {
+	fs := filesystem.New()
+	homeDir, err := fs.HomeDir()
+	s.Require().NoError(err)
+
+	root, err := resolveRoot(fs, "")
+	s.Require().NoError(err)
+	s.Require().Equal(filepath.Join(homeDir, "src"), root)
+
+	_, err = resolveRoot(fs, "src")
+	s.Require().EqualError(err, "src_root src must be absolute or start with ~")
+}
+
+func (s *reposSuite) TestCloneAndFind() {
+	bare := s.bareRepo()
+	client := s.newClient()
+	repo := &Repo{Host: "github.com", Org: "gumroad", Name: "web"}
+
+	path, err := client.Clone(repo, "file://"+bare)
+	s.Require().NoError(err)
+	s.Require().Equal(filepath.Join(s.root, "github.com", "gumroad", "web"), path)
+	s.Require().True(client.IsCloned(repo))
+
+	// a second clone is a no-op
+	path, err = client.Clone(repo, "file:///missing.git")
+	s.Require().NoError(err)
+	s.Require().Equal(filepath.Join(s.root, "github.com", "gumroad", "web"), path)
+
+	_, err = client.Clone(&Repo{Host: "github.com", Org: "gumroad", Name: "other"}, "file://"+bare)
+	s.Require().NoError(err)
+
+	for _, name := range []string{"web", "gumroad/web", "github.com/gumroad/web"} {
+		paths, err := client.Find(name)
+		s.Require().NoError(err)
+		s.Require().Equal([]string{path}, paths, name)
+	}
+
+	paths, err := client.Find("missing")
+	s.Require().NoError(err)
+	s.Require().Empty(paths)
+}
+
+func (s *reposSuite) TestCloneIntoExistingDirectory() {
+	repo := &Repo{Host: "github.com", Org: "gumroad", Name: "web"}
+	s.Require().NoError(os.MkdirAll(filepath.Join(s.root, "github.com", "gumroad", "web"), 0755))
+
+	_, err := s.newClient().Clone(repo, "file://"+s.bareRepo())
+
+	s.Require().ErrorContains(err, "exists and is not a git repository")
+}
+
+func TestReposSuite(t *testing.T) {
+	suite.Run(t, new(reposSuite))
+}
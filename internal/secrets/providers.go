@@ -0,0 +1,110 @@
+package secrets
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+)
+
+const (
+	onePasswordScheme = "op"
+	fileScheme        = "file"
+	envScheme         = "env"
+)
+
+// onePasswordProvider reads op://vault/item/field references with the
+// 1Password CLI, which has to be signed in.
+type onePasswordProvider struct {
+	cmdGen cmdexec.CmdGenerator
+}
+
+func newOnePasswordProvider(gen cmdexec.CmdGenerator) *onePasswordProvider {
+	return &onePasswordProvider{
+		cmdGen: gen,
+	}
+}
+
+func (p *onePasswordProvider) Scheme() string {
+	return onePasswordScheme
+}
+
+func (p *onePasswordProvider) Resolve(reference string) (string, error) {
+	cmd := p.cmdGen("op", "read", "--no-newline", reference)
+	if err := cmd.Run(); err != nil {
+		return "", errors.Errorf("op read failed, check that the 1Password CLI is installed and signed in: %s %s", err, strings.TrimSpace(cmd.Stderr()))
+	}
+
+	return cmd.Stdout(), nil
+}
+
+// fileProvider reads file:// references, such as file://~/.config/app/key or
+// file:///etc/app/key, without their trailing newline.
+type fileProvider struct {
+	fs filesystem.Client
+}
+
+func newFileProvider(fs filesystem.Client) *fileProvider {
+	return &fileProvider{
+		fs: fs,
+	}
+}
+
+func (p *fileProvider) Scheme() string {
+	return fileScheme
+}
+
+func (p *fileProvider) Resolve(reference string) (string, error) {
+	path := strings.TrimPrefix(reference, fileScheme+"://")
+
+	if rest, found := strings.CutPrefix(path, "~/"); found {
+		homeDir, err := p.fs.HomeDir()
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(homeDir, rest)
+	}
+
+	if !filepath.IsAbs(path) {
+		return "", errors.Errorf("File reference %s must be absolute or start with ~/", reference)
+	}
+
+	if !p.fs.IsFile(path) {
+		return "", errors.Errorf("File %s does not exist", path)
+	}
+
+	content, err := p.fs.ReadString(path)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(content, "\r\n"), nil
+}
+
+// envProvider reads env://NAME references from the environment of gum.
+type envProvider struct {
+	lookup func(name string) (string, bool)
+}
+
+func newEnvProvider(lookup func(name string) (string, bool)) *envProvider {
+	return &envProvider{
+		lookup: lookup,
+	}
+}
+
+func (p *envProvider) Scheme() string {
+	return envScheme
+}
+
+func (p *envProvider) Resolve(reference string) (string, error) {
+	name := strings.TrimPrefix(reference, envScheme+"://")
+
+	value, found := p.lookup(name)
+	if !found {
+		return "", errors.Errorf("Environment variable %s is not set", name)
+	}
+
+	return value, nil
+}
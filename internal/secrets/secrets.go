@@ -0,0 +1,112 @@
+package secrets
+
+import (
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+)
+
+var envNameRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Provider resolves the references of a scheme, such as op:// references
+// to 1Password items.
+type Provider interface {
+	Scheme() string
+	Resolve(reference string) (string, error)
+}
+
+// Client resolves the secrets of gum.yml, which map env var names to
+// references.
+type Client interface {
+	Resolve(references map[string]string) (map[string]string, error)
+}
+
+type client struct {
+	providers []Provider
+}
+
+func New() Client {
+	fs := filesystem.New()
+
+	return newClientWithComponents(
+		newOnePasswordProvider(cmdexec.NewCommandGenerator()),
+		newFileProvider(fs),
+		newEnvProvider(os.LookupEnv),
+	)
+}
+
+func newClientWithComponents(providers ...Provider) *client {
+	return &client{
+		providers: providers,
+	}
+}
+
+// Schemes lists the supported reference schemes.
+func Schemes() []string {
+	return []string{onePasswordScheme, fileScheme, envScheme}
+}
+
+// Validate checks the env var names and the schemes of the references.
+func Validate(references map[string]string) error {
+	for _, name := range sortedNames(references) {
+		if !envNameRe.MatchString(name) {
+			return errors.Errorf("Invalid secret name %q: it must be a valid env var name", name)
+		}
+
+		scheme, _, found := strings.Cut(references[name], "://")
+		if !found || !slices.Contains(Schemes(), scheme) {
+			return errors.Errorf("Invalid reference for secret %s: expected one of %s://", name, strings.Join(Schemes(), "://, "))
+		}
+	}
+
+	return nil
+}
+
+// Resolve returns the values of the references. They are redacted from the
+// logs as soon as they are resolved.
+func (c *client) Resolve(references map[string]string) (map[string]string, error) {
+	if err := Validate(references); err != nil {
+		return nil, err
+	}
+
+	values := map[string]string{}
+	for _, name := range sortedNames(references) {
+		reference := references[name]
+		scheme, _, _ := strings.Cut(reference, "://")
+
+		idx := slices.IndexFunc(c.providers, func(provider Provider) bool {
+			return provider.Scheme() == scheme
+		})
+		if idx < 0 {
+			return nil, errors.Errorf("No provider for secret %s", name)
+		}
+
+		log.Debugf("Resolving secret %s from %s", name, reference)
+
+		value, err := c.providers[idx].Resolve(reference)
+		if err != nil {
+			return nil, errors.Errorf("Failed to resolve secret %s: %s", name, err)
+		}
+
+		log.Redact(value)
+		values[name] = value
+	}
+
+	return values, nil
+}
+
+func sortedNames(references map[string]string) []string {
+	names := []string{}
+	for name := range references {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
@@ -0,0 +1,84 @@
+package secrets
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/cli/cmdexec/fakecmdexec"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type secretsSuite struct {
+	suite.Suite
+	tmpDir string
+}
+
+func (s *secretsSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *secretsSuite) SetupTest() {
+	s.tmpDir = s.T().TempDir()
+}
+
+func (s *secretsSuite) newClient(cmds ...fakecmdexec.SettableCommand) *client {
+	env := map[string]string{"GITHUB_TOKEN": "ghp_token"}
+	lookup := func(name string) (string, bool) {
+		value, found := env[name]
+		return value, found
+	}
+
+	return newClientWithComponents(
+		newOnePasswordProvider(fakecmdexec.NewCmdGenerator(cmds...)),
+		newFileProvider(filesystem.New()),
+		newEnvProvider(lookup),
+	)
+}
+
+func (s *secretsSuite) TestValidate() {
+	s.Require().NoError(Validate(map[string]string{"STRIPE_KEY": "op://dev/stripe/key"}))
+	s.Require().EqualError(Validate(map[string]string{"STRIPE-KEY": "env://STRIPE_KEY"}), `Invalid secret name "STRIPE-KEY": it must be a valid env var name`)
+	s.Require().EqualError(Validate(map[string]string{"STRIPE_KEY": "vault://stripe"}), "Invalid reference for secret STRIPE_KEY: expected one of op://, file://, env://")
+}
+
+func (s *secretsSuite) TestResolve() {
+	path := filepath.Join(s.tmpDir, "key")
+	s.Require().NoError(os.WriteFile(path, []byte("file_secret\n"), 0600))
+	op := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{Stdout: "sk_test"})
+
+	values, err := s.newClient(op).Resolve(map[string]string{
+		"STRIPE_KEY":   "op://dev/stripe/key",
+		"SIGNING_KEY":  "file://" + path,
+		"GITHUB_TOKEN": "env://GITHUB_TOKEN",
+	})
+
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]string{
+		"STRIPE_KEY":   "sk_test",
+		"SIGNING_KEY":  "file_secret",
+		"GITHUB_TOKEN": "ghp_token",
+	}, values)
+	s.Require().Equal([]string{"read", "--no-newline", "op://dev/stripe/key"}, op.Args())
+	s.Require().Equal("STRIPE_KEY=[REDACTED]", log.RedactString("STRIPE_KEY=sk_test"))
+}
+
+func (s *secretsSuite) TestResolveErrors() {
+	_, err := s.newClient().Resolve(map[string]string{"TOKEN": "env://MISSING"})
+	s.Require().EqualError(err, "Failed to resolve secret TOKEN: Environment variable MISSING is not set")
+
+	_, err = s.newClient().Resolve(map[string]string{"KEY": "file://relative/key"})
+	s.Require().EqualError(err, "Failed to resolve secret KEY: File reference file://relative/key must be absolute or start with ~/")
+
+	op := fakecmdexec.NewNoOpCommandWithOutputs(&fakecmdexec.NoOpOutputs{Err: errors.New("exit status 1"), Stderr: "not signed in\n"})
+	_, err = s.newClient(op).Resolve(map[string]string{"KEY": "op://dev/item/field"})
+	s.Require().EqualError(err, "Failed to resolve secret KEY: op read failed, check that the 1Password CLI is installed and signed in: exit status 1 not signed in")
+}
+
+func TestSecretsSuite(t *testing.T) {
+	suite.Run(t, new(secretsSuite))
+}
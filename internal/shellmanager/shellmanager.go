@@ -22,6 +22,9 @@ type Client interface {
 	GetShellProfilePath(shell ShellType) (string, error)
 	ProfileContains(shell ShellType, entry string) (bool, error)
 	UpdateShellProfile(shell ShellType, entry string) error
+	ManagedConfigPath(name string) (string, error)
+	ReadManagedConfig(name string) (string, error)
+	WriteManagedConfig(name, content string) error
 }
 
 type client struct {
@@ -99,3 +102,43 @@ func (c *client) ProfileContains(shell ShellType, entry string) (bool, error) {
 
 	return strings.Contains(string(content), entry), nil
 }
+
+// ManagedConfigPath is the path of a snippet sourced by the gum shell config.
+// Snippets live in ~/.gum/shell.d so that gum init can rewrite the shell
+// config without losing them.
+func (c *client) ManagedConfigPath(name string) (string, error) {
+	homeDir, err := c.fs.HomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".gum", "shell.d", name+".sh"), nil
+}
+
+// ReadManagedConfig returns the content of a snippet, or an empty string when
+// it doesn't exist.
+func (c *client) ReadManagedConfig(name string) (string, error) {
+	path, err := c.ManagedConfigPath(name)
+	if err != nil {
+		return "", err
+	}
+
+	if !c.fs.Exists(path) {
+		return "", nil
+	}
+
+	return c.fs.ReadString(path)
+}
+
+func (c *client) WriteManagedConfig(name, content string) error {
+	path, err := c.ManagedConfigPath(name)
+	if err != nil {
+		return err
+	}
+
+	if err := c.fs.MkdirAll(filepath.Dir(path)); err != nil {
+		return err
+	}
+
+	return c.fs.WriteString(path, content)
+}
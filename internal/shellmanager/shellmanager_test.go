@@ -69,6 +69,15 @@ func (s *shellManagerSuite) TestGetShellProfilePathWithUserHomeDirError() {
 	s.Require().Empty(actualPath)
 }
 
+func (s *shellManagerSuite) TestManagedConfigPath() {
+	client := newWithComponents(&fakeFileSystem{})
+
+	path, err := client.ManagedConfigPath("java")
+
+	s.Require().NoError(err)
+	s.Require().Equal("/home/testuser/.gum/shell.d/java.sh", path)
+}
+
 func TestShellManagerSuite(t *testing.T) {
 	suite.Run(t, new(shellManagerSuite))
 }
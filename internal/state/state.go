@@ -0,0 +1,116 @@
+package state
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+)
+
+// Client stores what gum has done on this machine, such as seeding a
+// database, in ~/.gum/state.json. Keys are namespaced by their users.
+type Client interface {
+	Get(key string) (string, bool, error)
+	Set(key, value string) error
+	Delete(key string) error
+}
+
+type client struct {
+	fs filesystem.Client
+}
+
+func New() Client {
+	return newClientWithComponents(filesystem.New())
+}
+
+func newClientWithComponents(fs filesystem.Client) *client {
+	return &client{
+		fs: fs,
+	}
+}
+
+func (c *client) Get(key string) (string, bool, error) {
+	values, err := c.load()
+	if err != nil {
+		return "", false, err
+	}
+
+	value, found := values[key]
+	return value, found, nil
+}
+
+func (c *client) Set(key, value string) error {
+	values, err := c.load()
+	if err != nil {
+		return err
+	}
+
+	values[key] = value
+	return c.save(values)
+}
+
+func (c *client) Delete(key string) error {
+	values, err := c.load()
+	if err != nil {
+		return err
+	}
+
+	if _, found := values[key]; !found {
+		return nil
+	}
+
+	delete(values, key)
+	return c.save(values)
+}
+
+func (c *client) path() (string, error) {
+	homeDir, err := c.fs.HomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(homeDir, ".gum", "state.json"), nil
+}
+
+func (c *client) load() (map[string]string, error) {
+	values := map[string]string{}
+
+	path, err := c.path()
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.fs.Exists(path) {
+		return values, nil
+	}
+
+	content, err := c.fs.ReadString(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(content), &values); err != nil {
+		return nil, errors.Errorf("Unable to read gum state %s: %s", path, err)
+	}
+
+	return values, nil
+}
+
+func (c *client) save(values map[string]string) error {
+	path, err := c.path()
+	if err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(values, "", "  ")
+	if err != nil {
+		return errors.Errorf("Unable to encode gum state: %s", err)
+	}
+
+	if err := c.fs.MkdirAll(filepath.Dir(path)); err != nil {
+		return err
+	}
+
+	return c.fs.WriteString(path, string(content)+"\n")
+}
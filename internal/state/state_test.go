@@ -0,0 +1,76 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type stateSuite struct {
+	suite.Suite
+	homeDir string
+}
+
+type fakeFileSystem struct {
+	filesystem.Client
+	homeDir string
+}
+
+func (f *fakeFileSystem) HomeDir() (string, error) {
+	return f.homeDir, nil
+}
+
+func (s *stateSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *stateSuite) SetupTest() {
+	dir, err := filesystem.New().MkdirTemp()
+	s.Require().NoError(err)
+	s.homeDir = dir
+}
+
+func (s *stateSuite) TearDownTest() {
+	os.RemoveAll(s.homeDir)
+}
+
+func (s *stateSuite) newClient() *client {
+	return newClientWithComponents(&fakeFileSystem{Client: filesystem.New(), homeDir: s.homeDir})
+}
+
+func (s *stateSuite) TestGetMissingFile() {
+	_, found, err := s.newClient().Get("database:/app:app_development:seeded")
+
+	s.Require().NoError(err)
+	s.Require().False(found)
+}
+
+func (s *stateSuite) TestSetAndDelete() {
+	client := s.newClient()
+
+	s.Require().NoError(client.Set("first", "1"))
+	s.Require().NoError(client.Set("second", "2"))
+
+	value, found, err := s.newClient().Get("first")
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal("1", value)
+
+	s.Require().NoError(client.Delete("first"))
+	_, found, err = client.Get("first")
+	s.Require().NoError(err)
+	s.Require().False(found)
+
+	content, err := os.ReadFile(filepath.Join(s.homeDir, ".gum", "state.json"))
+	s.Require().NoError(err)
+	s.Require().Equal("{\n  \"second\": \"2\"\n}\n", string(content))
+}
+
+func TestStateSuite(t *testing.T) {
+	suite.Run(t, new(stateSuite))
+}
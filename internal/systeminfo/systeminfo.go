@@ -4,7 +4,9 @@ import (
 	"os"
 	"os/user"
 	"runtime"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -14,6 +16,8 @@ var (
 	Linux  Platform = "linux"
 )
 
+const osReleasePath = "/etc/os-release"
+
 type Platform = string
 
 type Client interface {
@@ -21,12 +25,15 @@ type Client interface {
 	IsMacOS() bool
 	GetSudoOriginalUser() (*UserInfo, error)
 	IsSudo() bool
+	IsRoot() bool
 	GetSudoUsername() string
 	CurrentPlatform() Platform
+	Distro() (*Distro, error)
 }
 
 type client struct {
-	user userHandler
+	user          userHandler
+	osReleasePath string
 }
 
 type userHandler interface {
@@ -41,13 +48,31 @@ type UserInfo struct {
 	Name string
 }
 
+// Distro identifies a Linux distribution as described by os-release(5).
+type Distro struct {
+	ID     string
+	IDLike []string
+}
+
+// Is reports whether the distribution is, or is derived from, any of the ids.
+func (d *Distro) Is(ids ...string) bool {
+	for _, id := range ids {
+		if d.ID == id || slices.Contains(d.IDLike, id) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func New() Client {
 	return newClientWithComponents(newUserHandler())
 }
 
 func newClientWithComponents(user userHandler) Client {
 	return &client{
-		user: user,
+		user:          user,
+		osReleasePath: osReleasePath,
 	}
 }
 
@@ -63,10 +88,54 @@ func (c *client) IsMacOS() bool {
 	return runtime.GOOS == Darwin
 }
 
+func (c *client) Distro() (*Distro, error) {
+	if !c.IsLinux() {
+		return nil, errors.Errorf("Distribution detection is only supported on %s", Linux)
+	}
+
+	content, err := os.ReadFile(c.osReleasePath)
+	if err != nil {
+		return nil, errors.Errorf("Unable to read %s: %s", c.osReleasePath, err)
+	}
+
+	return parseOsRelease(string(content))
+}
+
+func parseOsRelease(content string) (*Distro, error) {
+	distro := &Distro{}
+
+	for _, line := range strings.Split(content, "\n") {
+		key, value, found := strings.Cut(strings.TrimSpace(line), "=")
+		if !found {
+			continue
+		}
+
+		value = strings.Trim(value, `"'`)
+
+		switch key {
+		case "ID":
+			distro.ID = strings.ToLower(value)
+		case "ID_LIKE":
+			distro.IDLike = strings.Fields(strings.ToLower(value))
+		}
+	}
+
+	if distro.ID == "" {
+		return nil, errors.Errorf("Distribution ID not found in os-release")
+	}
+
+	return distro, nil
+}
+
 func (c *client) IsSudo() bool {
 	return os.Getenv("SUDO_USER") != ""
 }
 
+// IsRoot reports whether gum runs as root, under sudo or not.
+func (c *client) IsRoot() bool {
+	return os.Geteuid() == 0
+}
+
 func (c *client) GetSudoUsername() string {
 	return os.Getenv("SUDO_USER")
 }
@@ -60,6 +60,36 @@ func (s *systeminfoSuite) TestGetSudoOriginalUserWithoutSudo() {
 	s.Require().EqualError(err, "Not running with sudo or SUDO_USER is not set")
 }
 
+func (s *systeminfoSuite) TestParseOsRelease() {
+	distro, err := parseOsRelease(`PRETTY_NAME="Ubuntu 24.04 LTS"
+NAME="Ubuntu"
+VERSION_ID="24.04"
+ID=ubuntu
+ID_LIKE=debian
+`)
+
+	s.Require().NoError(err)
+	s.Require().Equal("ubuntu", distro.ID)
+	s.Require().Equal([]string{"debian"}, distro.IDLike)
+	s.Require().True(distro.Is("debian"))
+	s.Require().True(distro.Is("fedora", "ubuntu"))
+	s.Require().False(distro.Is("arch"))
+}
+
+func (s *systeminfoSuite) TestParseOsReleaseQuotedIDLike() {
+	distro, err := parseOsRelease("ID=\"rocky\"\nID_LIKE=\"rhel centos fedora\"\n")
+
+	s.Require().NoError(err)
+	s.Require().Equal("rocky", distro.ID)
+	s.Require().Equal([]string{"rhel", "centos", "fedora"}, distro.IDLike)
+}
+
+func (s *systeminfoSuite) TestParseOsReleaseMissingID() {
+	_, err := parseOsRelease("NAME=Unknown\n")
+
+	s.Require().Error(err)
+}
+
 func TestSystemInfoSuite(t *testing.T) {
 	suite.Run(t, new(systeminfoSuite))
 }
@@ -0,0 +1,83 @@
+package templates
+
+import (
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// Data is what templates are rendered with. Environment variables are read
+// with the env function: {{ env "PGPORT" "5432" }}.
+type Data struct {
+	Vars       map[string]interface{}
+	User       string
+	Home       string
+	OS         string
+	Arch       string
+	GumHome    string
+	ProjectDir string
+}
+
+// Render renders a text/template, usually with a *Data. Missing vars are
+// errors rather than "<no value>".
+func Render(name, content string, data interface{}) (string, error) {
+	return render(name, content, data, os.LookupEnv)
+}
+
+func render(name, content string, data interface{}, lookupEnv func(string) (string, bool)) (string, error) {
+	funcs := template.FuncMap{
+		"env": func(name string, fallback ...string) (string, error) {
+			if value, found := lookupEnv(name); found {
+				return value, nil
+			}
+
+			if len(fallback) > 0 {
+				return fallback[0], nil
+			}
+
+			return "", errors.Errorf("environment variable %s is not set", name)
+		},
+	}
+
+	tmpl, err := template.New(name).Funcs(funcs).Option("missingkey=error").Parse(content)
+	if err != nil {
+		return "", errors.Errorf("Failed to parse template %s: %s", name, err)
+	}
+
+	b := &strings.Builder{}
+	if err := tmpl.Execute(b, data); err != nil {
+		return "", errors.Errorf("Failed to render template %s: %s", name, err)
+	}
+
+	return b.String(), nil
+}
+
+// Diff returns the unified diff between two versions of a file.
+func Diff(path, before, after string) string {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(before),
+		B:        splitLines(after),
+		FromFile: path,
+		ToFile:   path,
+		Context:  2,
+	})
+	if err != nil {
+		return ""
+	}
+
+	return diff
+}
+
+// splitLines splits content after its newlines, without the empty line
+// difflib.SplitLines adds after a trailing newline.
+func splitLines(content string) []string {
+	lines := strings.SplitAfter(content, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
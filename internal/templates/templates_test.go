@@ -0,0 +1,49 @@
+package templates
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type templatesSuite struct {
+	suite.Suite
+}
+
+func (s *templatesSuite) lookupEnv(name string) (string, bool) {
+	value, found := map[string]string{"PGPORT": "5433"}[name]
+	return value, found
+}
+
+func (s *templatesSuite) TestRender() {
+	data := &Data{Vars: map[string]interface{}{"database": "app"}, User: "gum"}
+
+	out, err := render("database.yml", `{{ .Vars.database }}_{{ .User }} {{ env "PGPORT" }} {{ env "PGHOST" "localhost" }}`, data, s.lookupEnv)
+
+	s.Require().NoError(err)
+	s.Require().Equal("app_gum 5433 localhost", out)
+}
+
+func (s *templatesSuite) TestRenderErrors() {
+	data := &Data{Vars: map[string]interface{}{}}
+
+	_, err := render("database.yml", "{{ .Vars.database }}", data, s.lookupEnv)
+	s.Require().ErrorContains(err, `Failed to render template database.yml`)
+	s.Require().ErrorContains(err, `map has no entry for key "database"`)
+
+	_, err = render("database.yml", `{{ env "PGHOST" }}`, data, s.lookupEnv)
+	s.Require().ErrorContains(err, "environment variable PGHOST is not set")
+
+	_, err = render("database.yml", "{{ .Vars", data, s.lookupEnv)
+	s.Require().ErrorContains(err, "Failed to parse template database.yml")
+}
+
+func (s *templatesSuite) TestDiff() {
+	diff := Diff("config/database.yml", "a\nb\n", "a\nc\n")
+
+	s.Require().Equal("--- config/database.yml\n+++ config/database.yml\n@@ -1,2 +1,2 @@\n a\n-b\n+c\n", diff)
+}
+
+func TestTemplatesSuite(t *testing.T) {
+	suite.Run(t, new(templatesSuite))
+}
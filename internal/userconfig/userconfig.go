@@ -0,0 +1,58 @@
+package userconfig
+
+import (
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/renegumroad/gum-cli/internal/yaml"
+)
+
+// UserConfig holds the developer preferences stored in ~/.gum/config.yml.
+// They apply to every project, unlike the gum.yml of a repository.
+type UserConfig struct {
+	VersionManager string `yaml:"version_manager,omitempty"`
+	// SrcRoot is where gum clone puts repositories, as <host>/<org>/<repo>.
+	// It defaults to ~/src.
+	SrcRoot string `yaml:"src_root,omitempty"`
+	// CloneProtocol is ssh (the default) or https.
+	CloneProtocol string `yaml:"clone_protocol,omitempty"`
+	// GitHubAPIURL is the base URL of the GitHub API gum install uses. It
+	// defaults to https://api.github.com.
+	GitHubAPIURL string `yaml:"github_api_url,omitempty"`
+}
+
+// Load reads the user config. A missing file is not an error, since every
+// setting is optional.
+func Load() (*UserConfig, error) {
+	return load(filesystem.New())
+}
+
+func load(fs filesystem.Client) (*UserConfig, error) {
+	config := &UserConfig{}
+
+	homeDir, err := fs.HomeDir()
+	if err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(homeDir, ".gum", "config.yml")
+	if !fs.Exists(path) {
+		log.Debugf("No user config found at %s", path)
+		return config, nil
+	}
+
+	log.Debugf("Reading user config %s", path)
+	content, err := fs.ReadString(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// an empty or comment-only file is a valid config
+	if err := yaml.Unmarshal([]byte(content), config); err != nil {
+		return nil, errors.Errorf("Invalid %s: %s", path, err)
+	}
+
+	return config, nil
+}
@@ -0,0 +1,83 @@
+package userconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/renegumroad/gum-cli/internal/filesystem"
+	"github.com/renegumroad/gum-cli/internal/log"
+	"github.com/stretchr/testify/suite"
+)
+
+type userConfigSuite struct {
+	suite.Suite
+	homeDir string
+}
+
+type fakeFileSystem struct {
+	filesystem.Client
+	homeDir string
+}
+
+func (f *fakeFileSystem) HomeDir() (string, error) {
+	return f.homeDir, nil
+}
+
+func (s *userConfigSuite) SetupSuite() {
+	err := log.Initialize(log.LogDisabled)
+	s.Require().NoError(err)
+}
+
+func (s *userConfigSuite) SetupTest() {
+	dir, err := filesystem.New().MkdirTemp()
+	s.Require().NoError(err)
+	s.homeDir = dir
+}
+
+func (s *userConfigSuite) TearDownTest() {
+	os.RemoveAll(s.homeDir)
+}
+
+func (s *userConfigSuite) TestLoadMissingFile() {
+	config, err := load(&fakeFileSystem{Client: filesystem.New(), homeDir: s.homeDir})
+
+	s.Require().NoError(err)
+	s.Require().Equal(&UserConfig{}, config)
+}
+
+func (s *userConfigSuite) TestLoad() {
+	s.Require().NoError(os.MkdirAll(filepath.Join(s.homeDir, ".gum"), 0755))
+	s.Require().NoError(os.WriteFile(filepath.Join(s.homeDir, ".gum", "config.yml"), []byte("version_manager: mise\n"), 0644))
+
+	config, err := load(&fakeFileSystem{Client: filesystem.New(), homeDir: s.homeDir})
+
+	s.Require().NoError(err)
+	s.Require().Equal("mise", config.VersionManager)
+}
+
+func (s *userConfigSuite) TestLoadEmptyFile() {
+	s.Require().NoError(os.MkdirAll(filepath.Join(s.homeDir, ".gum"), 0755))
+
+	for _, content := range []string{"", "# src_root: ~/code\n"} {
+		s.Require().NoError(os.WriteFile(filepath.Join(s.homeDir, ".gum", "config.yml"), []byte(content), 0644))
+
+		config, err := load(&fakeFileSystem{Client: filesystem.New(), homeDir: s.homeDir})
+
+		s.Require().NoError(err)
+		s.Require().Equal(&UserConfig{}, config)
+	}
+}
+
+func (s *userConfigSuite) TestLoadInvalidFile() {
+	s.Require().NoError(os.MkdirAll(filepath.Join(s.homeDir, ".gum"), 0755))
+	s.Require().NoError(os.WriteFile(filepath.Join(s.homeDir, ".gum", "config.yml"), []byte("src_root: [\n"), 0644))
+
+	_, err := load(&fakeFileSystem{Client: filesystem.New(), homeDir: s.homeDir})
+
+	s.Require().ErrorContains(err, "Invalid "+filepath.Join(s.homeDir, ".gum", "config.yml")+": Failed to unmarshal yaml")
+}
+
+func TestUserConfigSuite(t *testing.T) {
+	suite.Run(t, new(userConfigSuite))
+}
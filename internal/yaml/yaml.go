@@ -1,6 +1,7 @@
 package yaml
 
 import (
+	"bytes"
 	"os"
 	"reflect"
 
@@ -62,3 +63,32 @@ func (c *client) Load(data []byte, out interface{}) error {
 
 	return nil
 }
+
+// Unmarshal reads data into out like Client.Load, except that a document
+// without data, such as an empty or comment-only file, leaves out as is. It
+// suits files whose settings are all optional.
+func Unmarshal(data []byte, out interface{}) error {
+	if err := lib.Unmarshal(data, out); err != nil {
+		return errors.Errorf("Failed to unmarshal yaml: %s", err)
+	}
+
+	return nil
+}
+
+// Decode converts a generic value, such as a map read from a yaml document,
+// into out. Keys without a matching field in out are rejected.
+func Decode(value interface{}, out interface{}) error {
+	data, err := lib.Marshal(value)
+	if err != nil {
+		return errors.Errorf("Failed to marshal yaml: %s", err)
+	}
+
+	decoder := lib.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	if err := decoder.Decode(out); err != nil {
+		return errors.Errorf("Failed to decode yaml: %s", err)
+	}
+
+	return nil
+}
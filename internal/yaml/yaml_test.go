@@ -109,6 +109,34 @@ func (s *yamlSuite) TestReadDir() {
 	s.Require().Error(err)
 }
 
+func (s *yamlSuite) TestDecode() {
+	type Config struct {
+		Name  string   `yaml:"name"`
+		Tools []string `yaml:"tools"`
+	}
+
+	config := Config{}
+	err := Decode(map[string]interface{}{"name": "api", "tools": []interface{}{"gopls"}}, &config)
+	s.Require().NoError(err)
+	s.Require().Equal(Config{Name: "api", Tools: []string{"gopls"}}, config)
+
+	err = Decode(map[string]interface{}{"nme": "api"}, &Config{})
+	s.Require().ErrorContains(err, "field nme not found")
+}
+
+func (s *yamlSuite) TestUnmarshalEmptyDocument() {
+	type Config struct {
+		Name string `yaml:"name"`
+	}
+
+	config := Config{}
+	s.Require().NoError(Unmarshal([]byte("# nothing yet\n"), &config))
+	s.Require().Equal(Config{}, config)
+
+	s.Require().NoError(Unmarshal([]byte("name: api\n"), &config))
+	s.Require().Equal(Config{Name: "api"}, config)
+}
+
 func TestYamlSuite(t *testing.T) {
 	suite.Run(t, new(yamlSuite))
 }